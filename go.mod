@@ -9,8 +9,10 @@ require (
 	github.com/jaswdr/faker/v2 v2.8.1
 	github.com/pelletier/go-toml/v2 v2.2.4
 	github.com/peterbourgon/ff/v4 v4.0.0-beta.1
+	github.com/robfig/cron v1.2.0
 	github.com/rogpeppe/go-internal v1.14.1
 	github.com/stephenafamo/bob v0.41.1
+	github.com/stretchr/testify v1.10.0
 	github.com/tailscale/hujson v0.0.0-20250605163823-992244df8c5a
 	go.temporal.io/api v1.55.0
 	go.temporal.io/sdk v1.37.0
@@ -55,11 +57,9 @@ require (
 	github.com/pmezard/go-difflib v1.0.1-0.20181226105442-5d4384ee4fb2 // indirect
 	github.com/qdm12/reprint v0.0.0-20200326205758-722754a53494 // indirect
 	github.com/remyoudompheng/bigfft v0.0.0-20230129092748-24d4a6f8daec // indirect
-	github.com/robfig/cron v1.2.0 // indirect
 	github.com/sorairolake/lzip-go v0.3.5 // indirect
 	github.com/stephenafamo/scan v0.7.0 // indirect
 	github.com/stretchr/objx v0.5.2 // indirect
-	github.com/stretchr/testify v1.10.0 // indirect
 	github.com/ulikunitz/xz v0.5.15 // indirect
 	go.artefactual.dev/tools v0.21.0 // indirect
 	go.uber.org/multierr v1.11.0 // indirect
@@ -0,0 +1,167 @@
+package application
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"time"
+
+	"go.temporal.io/sdk/activity"
+	"go.temporal.io/sdk/interceptor"
+	"go.temporal.io/sdk/temporal"
+	"go.temporal.io/sdk/workflow"
+
+	"github.com/artefactual-labs/migrate/internal/storage_service"
+)
+
+// Error types of the application errors returned for Storage Service failures.
+// They can be listed in non_retryable_error_types.
+const (
+	StorageServiceErrorType            = "StorageServiceError"
+	StorageServiceUnavailableErrorType = "StorageServiceUnavailable"
+	StorageServiceNotFoundErrorType    = "StorageServiceNotFound"
+)
+
+// withActivityOptions applies the options configured for the named activity
+// on top of the ones already set in ctx.
+func withActivityOptions(ctx workflow.Context, activities ActivitiesConfig, name string) workflow.Context {
+	return workflow.WithActivityOptions(ctx, activities.options(name, workflow.GetActivityOptions(ctx)))
+}
+
+// executeActivity runs the activity with the options configured for it in
+// activities, i.e. workflows.move.activities or workflows.replicate.activities.
+func executeActivity(ctx workflow.Context, activities ActivitiesConfig, name string, args ...any) workflow.Future {
+	return workflow.ExecuteActivity(withActivityOptions(ctx, activities, name), name, args...)
+}
+
+// ownOptionsActivities keep the options set by their workflow over the
+// "default" entry, so the move is requested once and polls are retried a few
+// times. Only an entry named after them changes them.
+var ownOptionsActivities = []string{StartMoveActivityName, PollMoveActivityName}
+
+func (c ActivitiesConfig) options(name string, base workflow.ActivityOptions) workflow.ActivityOptions {
+	opts := base
+	if base.RetryPolicy != nil {
+		policy := *base.RetryPolicy
+		opts.RetryPolicy = &policy
+	} else {
+		opts.RetryPolicy = &temporal.RetryPolicy{}
+	}
+
+	keys := []string{"default", name}
+	if slices.Contains(ownOptionsActivities, name) {
+		keys = keys[1:]
+	}
+	for _, key := range keys {
+		cfg, ok := c[key]
+		if !ok {
+			continue
+		}
+		if cfg.StartToCloseTimeout > 0 {
+			opts.StartToCloseTimeout = time.Duration(cfg.StartToCloseTimeout)
+		}
+		if cfg.ScheduleToCloseTimeout > 0 {
+			opts.ScheduleToCloseTimeout = time.Duration(cfg.ScheduleToCloseTimeout)
+		}
+		if cfg.HeartbeatTimeout > 0 {
+			opts.HeartbeatTimeout = time.Duration(cfg.HeartbeatTimeout)
+		}
+		if cfg.InitialInterval > 0 {
+			opts.RetryPolicy.InitialInterval = time.Duration(cfg.InitialInterval)
+		}
+		if cfg.BackoffCoefficient > 0 {
+			opts.RetryPolicy.BackoffCoefficient = cfg.BackoffCoefficient
+		}
+		if cfg.MaximumInterval > 0 {
+			opts.RetryPolicy.MaximumInterval = time.Duration(cfg.MaximumInterval)
+		}
+		if cfg.MaximumAttempts > 0 {
+			opts.RetryPolicy.MaximumAttempts = cfg.MaximumAttempts
+		}
+		if len(cfg.NonRetryableErrorTypes) > 0 {
+			opts.RetryPolicy.NonRetryableErrorTypes = cfg.NonRetryableErrorTypes
+		}
+	}
+
+	return opts
+}
+
+// classifyError turns Storage Service errors into application errors so the
+// retry policy only retries the ones that may succeed on a later attempt:
+// server errors, timeouts and rate limiting.
+func classifyError(err error) error {
+	if err == nil {
+		return nil
+	}
+
+	var appErr *temporal.ApplicationError
+	if errors.As(err, &appErr) {
+		return err
+	}
+
+	if errors.Is(err, storage_service.ErrNotFound) {
+		return temporal.NewNonRetryableApplicationError(err.Error(), StorageServiceNotFoundErrorType, err)
+	}
+
+	var ssErr storage_service.SSError
+	if errors.As(err, &ssErr) {
+		if ssErr.Retryable() {
+			return temporal.NewApplicationError(err.Error(), StorageServiceUnavailableErrorType, err)
+		}
+		return temporal.NewNonRetryableApplicationError(err.Error(), StorageServiceErrorType, err)
+	}
+
+	return err
+}
+
+// NewErrorInterceptor returns a worker interceptor that classifies the errors
+// returned by every activity with classifyError.
+func NewErrorInterceptor() interceptor.WorkerInterceptor {
+	return &errorInterceptor{}
+}
+
+type errorInterceptor struct {
+	interceptor.WorkerInterceptorBase
+}
+
+func (*errorInterceptor) InterceptActivity(ctx context.Context, next interceptor.ActivityInboundInterceptor) interceptor.ActivityInboundInterceptor {
+	i := &activityErrorInterceptor{}
+	i.Next = next
+	return i
+}
+
+type activityErrorInterceptor struct {
+	interceptor.ActivityInboundInterceptorBase
+}
+
+func (i *activityErrorInterceptor) ExecuteActivity(ctx context.Context, in *interceptor.ExecuteActivityInput) (any, error) {
+	res, err := i.Next.ExecuteActivity(ctx, in)
+	return res, classifyError(err)
+}
+
+// heartbeat records heartbeats for the activity until the returned function is
+// called. It does nothing when the activity has no heartbeat timeout.
+func heartbeat(ctx context.Context) func() {
+	timeout := activity.GetInfo(ctx).HeartbeatTimeout
+	if timeout <= 0 {
+		return func() {}
+	}
+
+	done := make(chan struct{})
+	go func() {
+		ticker := time.NewTicker(timeout / 2)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-done:
+				return
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+				activity.RecordHeartbeat(ctx)
+			}
+		}
+	}()
+
+	return func() { close(done) }
+}
@@ -0,0 +1,109 @@
+package application
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	"go.temporal.io/sdk/temporal"
+	"go.temporal.io/sdk/workflow"
+	"gotest.tools/v3/assert"
+
+	"github.com/artefactual-labs/migrate/internal/storage_service"
+)
+
+func TestActivitiesConfigOptions(t *testing.T) {
+	t.Parallel()
+
+	base := workflow.ActivityOptions{
+		StartToCloseTimeout: time.Hour,
+		RetryPolicy:         &temporal.RetryPolicy{MaximumAttempts: 1},
+	}
+	cfg := ActivitiesConfig{
+		"default": {
+			StartToCloseTimeout: Duration(10 * time.Minute),
+			MaximumAttempts:     3,
+			BackoffCoefficient:  2,
+		},
+		"find-aip": {
+			HeartbeatTimeout:       Duration(time.Minute),
+			MaximumAttempts:        10,
+			NonRetryableErrorTypes: []string{StorageServiceNotFoundErrorType},
+		},
+	}
+
+	opts := cfg.options("find-aip", base)
+	assert.Equal(t, opts.StartToCloseTimeout, 10*time.Minute)
+	assert.Equal(t, opts.HeartbeatTimeout, time.Minute)
+	assert.DeepEqual(t, *opts.RetryPolicy, temporal.RetryPolicy{
+		BackoffCoefficient:     2,
+		MaximumAttempts:        10,
+		NonRetryableErrorTypes: []string{StorageServiceNotFoundErrorType},
+	})
+
+	opts = cfg.options("Replicate-aip", base)
+	assert.Equal(t, opts.StartToCloseTimeout, 10*time.Minute)
+	assert.Equal(t, opts.RetryPolicy.MaximumAttempts, int32(3))
+
+	// The default entry does not apply to the move activities.
+	opts = cfg.options(StartMoveActivityName, base)
+	assert.Equal(t, opts.StartToCloseTimeout, time.Hour)
+	assert.Equal(t, opts.RetryPolicy.MaximumAttempts, int32(1))
+
+	// The base options are left untouched.
+	assert.Equal(t, base.RetryPolicy.MaximumAttempts, int32(1))
+
+	// Without configuration the base options are used as is.
+	opts = ActivitiesConfig(nil).options("find-aip", base)
+	assert.Equal(t, opts.StartToCloseTimeout, time.Hour)
+	assert.Equal(t, opts.RetryPolicy.MaximumAttempts, int32(1))
+}
+
+func TestClassifyError(t *testing.T) {
+	t.Parallel()
+
+	for _, tc := range []struct {
+		name         string
+		err          error
+		errType      string
+		nonRetryable bool
+	}{
+		{
+			name:    "Server error",
+			err:     fmt.Errorf("get package: %w", storage_service.SSError{StatusCode: 502}),
+			errType: StorageServiceUnavailableErrorType,
+		},
+		{
+			name:    "Rate limited",
+			err:     storage_service.SSError{StatusCode: 429},
+			errType: StorageServiceUnavailableErrorType,
+		},
+		{
+			name:         "Client error",
+			err:          storage_service.SSError{StatusCode: 400},
+			errType:      StorageServiceErrorType,
+			nonRetryable: true,
+		},
+		{
+			name:         "Not found",
+			err:          storage_service.ErrNotFound,
+			errType:      StorageServiceNotFoundErrorType,
+			nonRetryable: true,
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			var appErr *temporal.ApplicationError
+			assert.Assert(t, errors.As(classifyError(tc.err), &appErr))
+			assert.Equal(t, appErr.Type(), tc.errType)
+			assert.Equal(t, appErr.NonRetryable(), tc.nonRetryable)
+		})
+	}
+
+	// Other errors are returned unchanged.
+	err := errors.New("database is locked")
+	assert.Equal(t, classifyError(err), err)
+	assert.NilError(t, classifyError(nil))
+}
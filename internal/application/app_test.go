@@ -0,0 +1,80 @@
+package application
+
+import (
+	"database/sql"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stephenafamo/bob"
+	"gotest.tools/v3/assert"
+	_ "modernc.org/sqlite"
+
+	"github.com/artefactual-labs/migrate/internal/database/gen/models"
+	"github.com/artefactual-labs/migrate/internal/database/migrations"
+	"github.com/artefactual-labs/migrate/internal/storage_service"
+)
+
+// newTestApp returns an App using a new database with the latest schema and
+// the default configuration.
+func newTestApp(t *testing.T) *App {
+	t.Helper()
+
+	sqlDB, err := sql.Open("sqlite", filepath.Join(t.TempDir(), "migrate.db"))
+	assert.NilError(t, err)
+	sqlDB.SetMaxOpenConns(1)
+	db := bob.NewDB(sqlDB)
+	t.Cleanup(func() { _ = db.Close() })
+
+	_, err = migrations.Migrate(t.Context(), db)
+	assert.NilError(t, err)
+
+	return New(slog.New(slog.DiscardHandler), db, DefaultConfig(), nil, nil)
+}
+
+// getTestAIP returns the AIP, failing the test when it is not in the database.
+func getTestAIP(t *testing.T, app *App, aipUUID string) *models.Aip {
+	t.Helper()
+
+	aip, err := app.GetAIPByID(t.Context(), aipUUID)
+	assert.NilError(t, err)
+	return aip
+}
+
+// newTestStorageService returns a Storage Service client for a server that
+// answers every request with handler.
+func newTestStorageService(t *testing.T, handler http.HandlerFunc) *storage_service.API {
+	t.Helper()
+
+	srv := httptest.NewServer(handler)
+	t.Cleanup(srv.Close)
+	return storage_service.NewAPI(srv.Client(), srv.URL, "test", "test-key")
+}
+
+// newTestManagement returns a management configuration running, in place of
+// manage.py, a shell script that prints output and exits with code. args
+// returns the arguments of the last run.
+func newTestManagement(t *testing.T, output string, code int) (cfg StorageServiceManagementConfig, args func() []string) {
+	t.Helper()
+
+	dir := t.TempDir()
+	argsPath := filepath.Join(dir, "args")
+	script := fmt.Sprintf("printf '%%s\\n' \"$@\" > %s\ncat <<'EOF'\n%s\nEOF\nexit %d\n", argsPath, output, code)
+	scriptPath := filepath.Join(dir, "manage.sh")
+	assert.NilError(t, os.WriteFile(scriptPath, []byte(script), 0o644))
+
+	cfg = StorageServiceManagementConfig{
+		Mode: ManagementModeHost,
+		Host: StorageServiceHostConfig{PythonPath: "/bin/sh", ManagePath: scriptPath},
+	}
+	return cfg, func() []string {
+		b, err := os.ReadFile(argsPath)
+		assert.NilError(t, err)
+		return strings.Fields(string(b))
+	}
+}
@@ -1,11 +1,12 @@
 package application
 
 import (
+	"cmp"
 	"context"
 	"encoding/csv"
 	"fmt"
+	"io"
 	"log/slog"
-	"os"
 	"strings"
 
 	"github.com/aarondl/opt/omit"
@@ -45,20 +46,24 @@ func New(logger *slog.Logger, db bob.DB, cfg *Config, temporalClient client.Clie
 type AIPStatus string
 
 const (
-	AIPStatusNew                   AIPStatus = "new"
-	AIPStatusFound                 AIPStatus = "found"
-	AIPStatusNotFound              AIPStatus = "not-found"
-	AIPStatusNoOp                  AIPStatus = "no-op"
-	AIPStatusFailed                AIPStatus = "failed"
-	AIPStatusFixityChecked         AIPStatus = "fixity-checked"
-	AIPStatusMoving                AIPStatus = "moving"
-	AIPStatusMoved                 AIPStatus = "moved"
-	AIPStatusCleaned               AIPStatus = "cleaned"
-	AIPStatusReplicated            AIPStatus = "replicated"
-	AIPStatusIndexed               AIPStatus = "indexed"
-	AIPStatusReplicationInProgress AIPStatus = "replication-in-progress"
-	AIPStatusFinished              AIPStatus = "finished"
-	AIPStatusDeleted               AIPStatus = "deleted"
+	AIPStatusNew                     AIPStatus = "new"
+	AIPStatusFound                   AIPStatus = "found"
+	AIPStatusNotFound                AIPStatus = "not-found"
+	AIPStatusNoOp                    AIPStatus = "no-op"
+	AIPStatusFailed                  AIPStatus = "failed"
+	AIPStatusFixityChecked           AIPStatus = "fixity-checked"
+	AIPStatusDestinationFixityFailed AIPStatus = "destination-fixity-failed"
+	AIPStatusMoving                  AIPStatus = "moving"
+	AIPStatusMoved                   AIPStatus = "moved"
+	AIPStatusCleaned                 AIPStatus = "cleaned"
+	AIPStatusReplicated              AIPStatus = "replicated"
+	AIPStatusIndexed                 AIPStatus = "indexed"
+	AIPStatusReplicationInProgress   AIPStatus = "replication-in-progress"
+	AIPStatusFinished                AIPStatus = "finished"
+	AIPStatusDeleted                 AIPStatus = "deleted"
+	AIPStatusCancelled               AIPStatus = "cancelled"
+	AIPStatusWaitingForWindow        AIPStatus = "waiting-for-window"
+	AIPStatusUnmappedLocation        AIPStatus = "unmapped-location"
 )
 
 type AIPReplicationStatus string
@@ -71,19 +76,16 @@ const (
 	AIPReplicationStatusFinished   AIPReplicationStatus = "finished"
 )
 
-func (a *App) ExportMove(ctx context.Context) error {
-	err := os.RemoveAll("move-report.csv")
-	if err != nil {
-		return err
-	}
-	file, err := os.Create("move-report.csv")
-	if err != nil {
-		return err
-	}
-	defer file.Close() //nolint:errcheck
+// Default report files.
+const (
+	MoveReportFile        = "move-report.csv"
+	ReplicationReportFile = "replication-report.csv"
+)
 
-	writer := csv.NewWriter(file)
-	defer writer.Flush()
+// ExportMove writes the move report to w, limited to the AIPs of the batch
+// when it is not nil.
+func (a *App) ExportMove(ctx context.Context, w io.Writer, batch *models.Batch) error {
+	writer := csv.NewWriter(w)
 
 	headers := []string{
 		"UUID",
@@ -104,12 +106,16 @@ func (a *App) ExportMove(ctx context.Context) error {
 		"Errors",
 	}
 
-	q := models.Aips.Query()
+	q := models.Aips.Query(inBatch(models.Aips.Columns.ID, batch))
 	q.Apply(models.SelectThenLoad.Aip.Errors())
 	aips, err := q.All(ctx, a.DB)
 	if err != nil {
 		return err
 	}
+	statuses, err := a.batchStatuses(ctx, batch)
+	if err != nil {
+		return err
+	}
 
 	data := make([][]string, len(aips))
 	for idx, aip := range aips {
@@ -120,7 +126,7 @@ func (a *App) ExportMove(ctx context.Context) error {
 
 		row := []string{
 			aip.UUID,
-			aip.Status,
+			cmp.Or(statuses[aip.ID], aip.Status),
 			// aip.TotalDuration.GetOrZero(),
 			formatBool(aip.FixityRun),
 			formatBool(aip.Moved),
@@ -150,43 +156,38 @@ func (a *App) ExportMove(ctx context.Context) error {
 			return err
 		}
 	}
-	a.logger.Info("Move export generated", "path", "move-report.csv")
-	return nil
+	writer.Flush()
+	return writer.Error()
 }
 
-func (a *App) ExportReplication(ctx context.Context) error {
-	err := os.RemoveAll("replication-report.csv")
-	if err != nil {
-		return err
-	}
-
-	file, err := os.Create("replication-report.csv")
-	if err != nil {
-		return err
-	}
-
-	defer file.Close() //nolint:errcheck
-
-	writer := csv.NewWriter(file)
-	defer writer.Flush()
+// ExportReplication writes the replication report to w, limited to the AIPs of
+// the batch when it is not nil.
+func (a *App) ExportReplication(ctx context.Context, w io.Writer, batch *models.Batch) error {
+	writer := csv.NewWriter(w)
 
 	headers := []string{
 		"UUID",
 		"AIPStatus",
 		"Location",
+		"Replicas",
 		"Size",
 		"Size Bytes",
 		"Total Size",
 	}
 
-	q := models.Aips.Query()
+	q := models.Aips.Query(inBatch(models.Aips.Columns.ID, batch))
 	q.Apply(models.SelectThenLoad.Aip.Errors())
 	q.Apply(models.SelectThenLoad.Aip.Events())
+	q.Apply(models.SelectThenLoad.Aip.AipReplications())
 	aips, err := q.All(ctx, a.DB)
 	if err != nil {
 		return err
 	}
 	SortAips(aips)
+	statuses, err := a.batchStatuses(ctx, batch)
+	if err != nil {
+		return err
+	}
 
 	data := make([][]string, len(aips)+1)
 
@@ -194,17 +195,24 @@ func (a *App) ExportReplication(ctx context.Context) error {
 	for idx, aip := range aips {
 		// TODO: aip.R.Events and aip.R.Errors?
 		totalSize += aip.Size.GetOrZero()
+		replicas := make([]string, 0, len(aip.R.AipReplications))
+		for _, r := range aip.R.AipReplications {
+			if id := r.ReplicaUUID.GetOrZero(); id != "" {
+				replicas = append(replicas, r.LocationUUID.GetOrZero()+": "+id)
+			}
+		}
 		row := []string{
 			aip.UUID,
-			aip.Status,
+			cmp.Or(statuses[aip.ID], aip.Status),
 			aip.CurrentLocation.GetOrZero(),
+			strings.Join(replicas, "\n"),
 			formatByteSize(aip.Size.GetOrZero()),
 			fmt.Sprintf("%d", aip.Size.GetOrZero()),
 		}
 
 		data[idx] = row
 	}
-	data[len(aips)] = []string{"", "", "", "", formatByteSize(totalSize)}
+	data[len(aips)] = []string{"", "", "", "", "", formatByteSize(totalSize)}
 
 	err = writer.Write(headers)
 	if err != nil {
@@ -216,6 +224,10 @@ func (a *App) ExportReplication(ctx context.Context) error {
 			return err
 		}
 	}
+	writer.Flush()
+	if err := writer.Error(); err != nil {
+		return err
+	}
 	a.logger.Info("Success!")
 	return nil
 }
@@ -234,6 +246,14 @@ func (a *App) UpdateAIP(ctx context.Context, id int64, setter *models.AipSetter)
 	return err
 }
 
+func (a *App) SetAIPPriority(ctx context.Context, uuid string, priority int64) error {
+	_, err := models.Aips.Update(
+		models.AipSetter{Priority: omit.From(priority)}.UpdateMod(),
+		models.UpdateWhere.Aips.UUID.EQ(uuid),
+	).Exec(ctx, a.DB)
+	return err
+}
+
 func (a *App) UpdateAIPStatus(ctx context.Context, id int64, s AIPStatus) error {
 	setter := &models.AipSetter{Status: omit.From(string(s))}
 	switch s {
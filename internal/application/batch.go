@@ -0,0 +1,324 @@
+package application
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/aarondl/opt/omit"
+	"github.com/google/uuid"
+	"github.com/stephenafamo/bob"
+	"github.com/stephenafamo/bob/dialect/sqlite"
+	"github.com/stephenafamo/bob/dialect/sqlite/dialect"
+	"github.com/stephenafamo/bob/dialect/sqlite/im"
+	"github.com/stephenafamo/bob/dialect/sqlite/sm"
+
+	"github.com/artefactual-labs/migrate/internal/database/gen/models"
+	"github.com/artefactual-labs/migrate/internal/storage_service"
+)
+
+// GetBatch returns the named batch.
+func (a *App) GetBatch(ctx context.Context, name string) (*models.Batch, error) {
+	batch, err := models.Batches.Query(models.SelectWhere.Batches.Name.EQ(name)).One(ctx, a.DB)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, fmt.Errorf("batch %q not found", name)
+	} else if err != nil {
+		return nil, fmt.Errorf("get batch: %w", err)
+	}
+	return batch, nil
+}
+
+// EnsureBatch returns the named batch, creating it when it does not exist.
+// The operation, recorded with a snapshot of the configuration, is set by the
+// first move or replicate run of the batch; op is empty when loading the
+// input. The checksum of the first input read for the batch is kept, and a
+// different input only logs a warning since AIPs can be added to a batch over
+// several runs. Later runs of the batch likewise warn when the configuration
+// differs from its snapshot.
+func (a *App) EnsureBatch(ctx context.Context, name string, op BatchOperation, input *Input) (*models.Batch, error) {
+	var checksum string
+	if input != nil {
+		checksum = input.Checksum
+	}
+	snapshot, err := a.configSnapshot()
+	if err != nil {
+		return nil, err
+	}
+
+	batch, err := models.Batches.Query(models.SelectWhere.Batches.Name.EQ(name)).One(ctx, a.DB)
+	if errors.Is(err, sql.ErrNoRows) {
+		batch, err = models.Batches.Insert(&models.BatchSetter{
+			Name:          omit.From(name),
+			Operation:     omit.From(string(op)),
+			CreatedAt:     omit.From(time.Now().UTC().Format(time.RFC3339)),
+			InputChecksum: omit.From(checksum),
+			Config:        omit.From(snapshot),
+		}).One(ctx, a.DB)
+		if err != nil {
+			return nil, fmt.Errorf("insert batch: %w", err)
+		}
+		a.logger.Info("Batch created.", "batch", name)
+		return batch, nil
+	} else if err != nil {
+		return nil, fmt.Errorf("get batch: %w", err)
+	}
+
+	setter := &models.BatchSetter{}
+	update := false
+	if op != "" {
+		switch batch.Operation {
+		case "":
+			setter.Operation = omit.From(string(op))
+			setter.Config = omit.From(snapshot)
+			update = true
+		case string(op):
+			if batch.Config != "" && batch.Config != snapshot {
+				a.logger.Warn("The configuration differs from the one the batch was started with.", "batch", name)
+			}
+		default:
+			return nil, fmt.Errorf("batch %q is a %s batch, not a %s one", name, batch.Operation, op)
+		}
+	}
+	switch {
+	case checksum == "" || checksum == batch.InputChecksum:
+	case batch.InputChecksum == "":
+		setter.InputChecksum = omit.From(checksum)
+		update = true
+	default:
+		a.logger.Warn("The input differs from the one the batch was created with.", "batch", name)
+	}
+	if update {
+		if err := batch.Update(ctx, a.DB, setter); err != nil {
+			return nil, fmt.Errorf("update batch: %w", err)
+		}
+	}
+	return batch, nil
+}
+
+// batchFinishedStatuses are the statuses of the AIPs whose work is done.
+var batchFinishedStatuses = []string{
+	string(AIPStatusMoved),
+	string(AIPStatusIndexed),
+	string(AIPStatusCleaned),
+	string(AIPStatusReplicated),
+	string(AIPStatusFinished),
+}
+
+// AddBatchAIPs adds the AIPs to the batch, creating the AIPs that are not in
+// the database yet. An AIP finished in another batch is processed again when
+// it joins this one, see resetBatchAIP.
+func (a *App) AddBatchAIPs(ctx context.Context, batch *models.Batch, uuids []uuid.UUID) error {
+	locations := a.Config.WorkflowSettings().Locations
+	return a.DB.RunInTx(ctx, nil, func(ctx context.Context, exec bob.Executor) error {
+		for _, id := range uuids {
+			if _, err := models.Aips.Insert(
+				&models.AipSetter{
+					UUID:   omit.From(id.String()),
+					Status: omit.From(string(AIPStatusNew)),
+				},
+				im.OnConflict("uuid").DoNothing(),
+			).Exec(ctx, exec); err != nil {
+				return fmt.Errorf("insert AIP: %w", err)
+			}
+			aip, err := models.Aips.Query(models.SelectWhere.Aips.UUID.EQ(id.String())).One(ctx, exec)
+			if err != nil {
+				return fmt.Errorf("get AIP by ID: %w", err)
+			}
+			_, err = models.BatchAips.Insert(
+				&models.BatchAipSetter{
+					BatchID: omit.From(batch.ID),
+					AipID:   omit.From(aip.ID),
+				},
+				im.OnConflict("batch_id", "aip_id").DoNothing(),
+			).One(ctx, exec)
+			if errors.Is(err, sql.ErrNoRows) {
+				// The AIP is already in the batch.
+				continue
+			} else if err != nil {
+				return fmt.Errorf("insert batch AIP: %w", err)
+			}
+			if !slices.Contains(batchFinishedStatuses, aip.Status) {
+				continue
+			}
+			others, err := models.BatchAips.Query(
+				models.SelectWhere.BatchAips.AipID.EQ(aip.ID),
+				models.SelectWhere.BatchAips.BatchID.NE(batch.ID),
+			).Count(ctx, exec)
+			if err != nil {
+				return fmt.Errorf("count batches of AIP: %w", err)
+			}
+			if others == 0 {
+				continue
+			}
+			if err := a.resetBatchAIP(ctx, exec, locations, batch, aip); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
+// resetBatchAIP gives an AIP finished in other batches the new status. The
+// status it had is recorded in its rows of those batches, whose reports keep
+// it. The progress made for other targets than those of the batch is cleared:
+// the move when the AIP is not stored in the move target, and the
+// replications when the replication targets differ from the recorded ones.
+// The done pipeline steps go with them.
+func (a *App) resetBatchAIP(ctx context.Context, exec bob.Executor, locations StorageServiceLocationConfig, batch *models.Batch, aip *models.Aip) error {
+	if _, err := models.BatchAips.Update(
+		(&models.BatchAipSetter{Status: omit.From(aip.Status)}).UpdateMod(),
+		models.UpdateWhere.BatchAips.AipID.EQ(aip.ID),
+		models.UpdateWhere.BatchAips.BatchID.NE(batch.ID),
+		models.UpdateWhere.BatchAips.Status.EQ(""),
+	).Exec(ctx, exec); err != nil {
+		return fmt.Errorf("record batch AIP status: %w", err)
+	}
+
+	setter := &models.AipSetter{Status: omit.From(string(AIPStatusNew))}
+	retargeted := false
+	target := locations.forAIP(aip).MoveTargetLocationID
+	if aip.Moved && target != "" && !strings.Contains(aip.CurrentLocation.GetOrZero(), target) {
+		setter.Moved = omit.From(false)
+		setter.Cleaned = omit.From(false)
+		setter.ReIndexed = omit.From(false)
+		retargeted = true
+	}
+
+	replications, err := aip.AipReplications().All(ctx, exec)
+	if err != nil {
+		return fmt.Errorf("list AIP replications: %w", err)
+	}
+	if len(replications) > 0 {
+		targets, err := a.batchReplicationTargets(ctx, locations, aip)
+		if err != nil {
+			return err
+		}
+		recorded := make([]string, len(replications))
+		for i, r := range replications {
+			recorded[i] = r.LocationUUID.GetOrZero()
+		}
+		slices.Sort(recorded)
+		slices.Sort(targets)
+		if !slices.Equal(recorded, targets) {
+			// InitAIPInDatabase records the targets of the batch again.
+			if _, err := models.AipReplications.Delete(
+				models.DeleteWhere.AipReplications.AipID.EQ(aip.ID),
+			).Exec(ctx, exec); err != nil {
+				return fmt.Errorf("delete AIP replications: %w", err)
+			}
+			setter.Replicated = omit.From(false)
+			retargeted = true
+		}
+	}
+
+	if retargeted {
+		if _, err := models.AipSteps.Delete(
+			models.DeleteWhere.AipSteps.AipID.EQ(aip.ID),
+			models.DeleteWhere.AipSteps.Status.EQ(string(AIPStepStatusDone)),
+		).Exec(ctx, exec); err != nil {
+			return fmt.Errorf("delete AIP steps: %w", err)
+		}
+	}
+
+	previous := aip.Status
+	if err := aip.Update(ctx, exec, setter); err != nil {
+		return fmt.Errorf("reset AIP status: %w", err)
+	}
+	a.logger.Info("AIP status reset for the batch.", "batch", batch.Name, "UUID", aip.UUID, "previous", previous, "retargeted", retargeted)
+	return nil
+}
+
+// batchReplicationTargets returns the replication targets of the AIP in a
+// batch started with the locations. The package is only looked up when
+// replication rules choose the targets.
+func (a *App) batchReplicationTargets(ctx context.Context, locations StorageServiceLocationConfig, aip *models.Aip) ([]string, error) {
+	var pkg *storage_service.Package
+	if len(locations.ReplicationRules) > 0 && aip.ReplicationTargetsOverride == "" {
+		var err error
+		if pkg, err = a.getPackage(ctx, aip.UUID); err != nil {
+			return nil, err
+		}
+	}
+	rts, err := aipReplicationTargets(locations, aip, pkg)
+	if err != nil {
+		return nil, err
+	}
+	targets := make([]string, len(rts))
+	for i, t := range rts {
+		targets[i] = t.LocationID
+	}
+	return targets, nil
+}
+
+// batchStatuses returns, by AIP ID, the statuses the AIPs of the batch ended
+// it with when they joined a later batch since. The other AIPs have their
+// status in the aips table.
+func (a *App) batchStatuses(ctx context.Context, batch *models.Batch) (map[int64]string, error) {
+	if batch == nil {
+		return nil, nil
+	}
+	members, err := models.BatchAips.Query(
+		models.SelectWhere.BatchAips.BatchID.EQ(batch.ID),
+		models.SelectWhere.BatchAips.Status.NE(""),
+	).All(ctx, a.DB)
+	if err != nil {
+		return nil, fmt.Errorf("list batch AIP statuses: %w", err)
+	}
+	statuses := make(map[int64]string, len(members))
+	for _, m := range members {
+		statuses[m.AipID] = m.Status
+	}
+	return statuses, nil
+}
+
+// BatchUUIDs returns the UUIDs of the AIPs of the batch, in the order they
+// were added.
+func (a *App) BatchUUIDs(ctx context.Context, batch *models.Batch) ([]uuid.UUID, error) {
+	members, err := batch.BatchAips(
+		sm.OrderBy(models.BatchAips.Columns.ID),
+		models.SelectThenLoad.BatchAip.Aip(),
+	).All(ctx, a.DB)
+	if err != nil {
+		return nil, fmt.Errorf("list batch AIPs: %w", err)
+	}
+	uuids := make([]uuid.UUID, len(members))
+	for i, m := range members {
+		if uuids[i], err = uuid.Parse(m.R.Aip.UUID); err != nil {
+			return nil, fmt.Errorf("parse AIP UUID: %w", err)
+		}
+	}
+	return uuids, nil
+}
+
+// inBatch restricts a query to the rows whose AIP, identified by the aipID
+// column, is in the batch. A nil batch leaves the query as is.
+func inBatch(aipID dialect.Expression, batch *models.Batch) bob.Mod[*dialect.SelectQuery] {
+	if batch == nil {
+		return bob.ModFunc[*dialect.SelectQuery](func(*dialect.SelectQuery) {})
+	}
+	return sm.Where(aipID.In(sqlite.Select(
+		sm.Columns(models.BatchAips.Columns.AipID),
+		sm.From(models.BatchAips.Name()),
+		sm.Where(models.BatchAips.Columns.BatchID.EQ(sqlite.Arg(batch.ID))),
+	)))
+}
+
+// configSnapshot encodes the configuration recorded with batches, without the
+// Storage Service API key.
+func (a *App) configSnapshot() (string, error) {
+	if a.Config == nil {
+		return "", nil
+	}
+	cfg := *a.Config
+	cfg.StorageService.API.APIKey = ""
+	b, err := json.Marshal(cfg)
+	if err != nil {
+		return "", fmt.Errorf("encode config snapshot: %w", err)
+	}
+	return string(b), nil
+}
@@ -0,0 +1,192 @@
+package application
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/aarondl/opt/omit"
+	"github.com/aarondl/opt/omitnull"
+	"github.com/google/uuid"
+	"gotest.tools/v3/assert"
+
+	"github.com/artefactual-labs/migrate/internal/database/gen/models"
+)
+
+func TestAddBatchAIPs(t *testing.T) {
+	t.Parallel()
+
+	aipUUID := uuid.MustParse("8e2d4f6a-1b3c-4d5e-9f7a-2b4c6d8e0f1a")
+
+	// add adds the AIP to the named batch.
+	add := func(t *testing.T, app *App, name string) {
+		t.Helper()
+		batch, err := app.EnsureBatch(t.Context(), name, BatchOperationReplicate, nil)
+		assert.NilError(t, err)
+		assert.NilError(t, app.AddBatchAIPs(t.Context(), batch, []uuid.UUID{aipUUID}))
+	}
+
+	// setupAIP returns an App with the AIP, in the batches named.
+	setupAIP := func(t *testing.T, setter *models.AipSetter, batches ...string) *App {
+		app := newTestApp(t)
+		setter.UUID = omit.From(aipUUID.String())
+		_, err := models.Aips.Insert(setter).Exec(t.Context(), app.DB)
+		assert.NilError(t, err)
+		for _, name := range batches {
+			add(t, app, name)
+		}
+		return app
+	}
+
+	// setup returns an App with an AIP of the given status, in the batches
+	// named.
+	setup := func(t *testing.T, status AIPStatus, batches ...string) *App {
+		return setupAIP(t, &models.AipSetter{
+			Status:     omit.From(string(status)),
+			Replicated: omit.From(status == AIPStatusReplicated),
+		}, batches...)
+	}
+
+	// moved returns an App with an AIP moved to the target location in the
+	// phase-1 batch, and with a done pipeline step.
+	moved := func(t *testing.T, target string) *App {
+		app := setupAIP(t, &models.AipSetter{
+			Status:          omit.From(string(AIPStatusIndexed)),
+			Moved:           omit.From(true),
+			Cleaned:         omit.From(true),
+			ReIndexed:       omit.From(true),
+			CurrentLocation: omitnull.From("/api/v2/location/" + target + "/"),
+		}, "phase-1")
+		_, err := models.AipSteps.Insert(&models.AipStepSetter{
+			AipID:     omit.From(getTestAIP(t, app, aipUUID.String()).ID),
+			Step:      omit.From(PipelineStepMove),
+			Status:    omit.From(string(AIPStepStatusDone)),
+			StartedAt: omit.From("2025-01-01T00:00:00Z"),
+		}).Exec(t.Context(), app.DB)
+		assert.NilError(t, err)
+		return app
+	}
+
+	// steps returns the number of pipeline steps of the AIP.
+	steps := func(t *testing.T, app *App) int64 {
+		n, err := models.AipSteps.Query().Count(t.Context(), app.DB)
+		assert.NilError(t, err)
+		return n
+	}
+
+	t.Run("Resets the status of an AIP finished in another batch", func(t *testing.T) {
+		t.Parallel()
+
+		app := setup(t, AIPStatusReplicated, "phase-1")
+		add(t, app, "phase-2")
+
+		aip := getTestAIP(t, app, aipUUID.String())
+		assert.Equal(t, aip.Status, string(AIPStatusNew))
+		assert.Equal(t, aip.Replicated, true)
+	})
+
+	t.Run("Keeps the status of the AIP in the reports of the earlier batch", func(t *testing.T) {
+		t.Parallel()
+
+		app := setup(t, AIPStatusReplicated, "phase-1")
+		add(t, app, "phase-2")
+
+		for name, want := range map[string]string{"phase-1": "replicated", "phase-2": "new"} {
+			batch, err := app.GetBatch(t.Context(), name)
+			assert.NilError(t, err)
+			status, err := app.Status(t.Context(), 0, batch)
+			assert.NilError(t, err)
+			assert.DeepEqual(t, status.AIPs, map[string]int{want: 1})
+
+			var b strings.Builder
+			assert.NilError(t, app.ExportMove(t.Context(), &b, batch))
+			assert.Assert(t, strings.Contains(b.String(), aipUUID.String()+","+want+","), b.String())
+		}
+	})
+
+	t.Run("Clears the move to another target", func(t *testing.T) {
+		t.Parallel()
+
+		app := moved(t, "earlier-target")
+		app.Config.StorageService.Locations.MoveTargetLocationID = "new-target"
+		add(t, app, "phase-2")
+
+		aip := getTestAIP(t, app, aipUUID.String())
+		assert.Equal(t, aip.Status, string(AIPStatusNew))
+		assert.Equal(t, aip.Moved, false)
+		assert.Equal(t, aip.Cleaned, false)
+		assert.Equal(t, aip.ReIndexed, false)
+		assert.Equal(t, steps(t, app), int64(0))
+	})
+
+	t.Run("Keeps the move to the same target", func(t *testing.T) {
+		t.Parallel()
+
+		app := moved(t, "new-target")
+		app.Config.StorageService.Locations.MoveTargetLocationID = "new-target"
+		add(t, app, "phase-2")
+
+		aip := getTestAIP(t, app, aipUUID.String())
+		assert.Equal(t, aip.Status, string(AIPStatusNew))
+		assert.Equal(t, aip.Moved, true)
+		assert.Equal(t, aip.ReIndexed, true)
+		assert.Equal(t, steps(t, app), int64(1))
+	})
+
+	t.Run("Clears the replications to other targets", func(t *testing.T) {
+		t.Parallel()
+
+		for _, tc := range []struct {
+			name    string
+			targets []ReplicationTarget
+			want    int
+		}{
+			{name: "Other targets", targets: []ReplicationTarget{{ID: "new-replica"}}},
+			{name: "Same targets", targets: []ReplicationTarget{{ID: "earlier-replica"}}, want: 1},
+		} {
+			t.Run(tc.name, func(t *testing.T) {
+				t.Parallel()
+
+				app := setup(t, AIPStatusReplicated, "phase-1")
+				aip := getTestAIP(t, app, aipUUID.String())
+				assert.NilError(t, aip.InsertAipReplications(t.Context(), app.DB, &models.AipReplicationSetter{
+					AipID:        omit.From(aip.ID),
+					LocationUUID: omitnull.From("earlier-replica"),
+					Status:       omit.From(string(AIPReplicationStatusFinished)),
+				}))
+				app.Config.StorageService.Locations.ReplicationTargets = tc.targets
+				add(t, app, "phase-2")
+
+				replications, err := models.AipReplications.Query().Count(t.Context(), app.DB)
+				assert.NilError(t, err)
+				assert.Equal(t, replications, int64(tc.want))
+				assert.Equal(t, getTestAIP(t, app, aipUUID.String()).Replicated, tc.want > 0)
+			})
+		}
+	})
+
+	t.Run("Keeps the status of an AIP joining its first batch", func(t *testing.T) {
+		t.Parallel()
+
+		app := setup(t, AIPStatusReplicated)
+		add(t, app, "phase-1")
+		assert.Equal(t, getTestAIP(t, app, aipUUID.String()).Status, string(AIPStatusReplicated))
+	})
+
+	t.Run("Keeps the status of an AIP already in the batch", func(t *testing.T) {
+		t.Parallel()
+
+		app := setup(t, AIPStatusReplicated, "phase-1", "phase-2")
+		// The AIP is replicated again in phase-2.
+		assert.NilError(t, app.UpdateAIPStatus(t.Context(), getTestAIP(t, app, aipUUID.String()).ID, AIPStatusReplicated))
+		add(t, app, "phase-2")
+		assert.Equal(t, getTestAIP(t, app, aipUUID.String()).Status, string(AIPStatusReplicated))
+	})
+
+	t.Run("Keeps the status of an AIP not finished", func(t *testing.T) {
+		t.Parallel()
+
+		app := setup(t, AIPStatusFound, "phase-1")
+		add(t, app, "phase-2")
+		assert.Equal(t, getTestAIP(t, app, aipUUID.String()).Status, string(AIPStatusFound))
+	})
+}
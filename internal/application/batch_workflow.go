@@ -0,0 +1,406 @@
+package application
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"time"
+
+	"github.com/aarondl/opt/omit"
+	"github.com/google/uuid"
+	"github.com/stephenafamo/bob"
+	"github.com/stephenafamo/bob/dialect/sqlite/sm"
+	"go.temporal.io/api/enums/v1"
+	"go.temporal.io/sdk/client"
+	"go.temporal.io/sdk/temporal"
+	"go.temporal.io/sdk/workflow"
+
+	"github.com/artefactual-labs/migrate/internal/database/gen/models"
+)
+
+// BatchOperation identifies the per-AIP workflow started by a batch.
+type BatchOperation string
+
+const (
+	BatchOperationMove      BatchOperation = "move"
+	BatchOperationReplicate BatchOperation = "replicate"
+	BatchOperationPipeline  BatchOperation = "pipeline"
+)
+
+// batchChildrenPerRun caps how many child workflows a single batch run starts
+// before it continues as new, keeping the event history bounded.
+const batchChildrenPerRun = 500
+
+// batchPageSize is the number of AIPs a batch workflow loads at a time.
+const batchPageSize = 100
+
+type BatchWorkflowParams struct {
+	Operation BatchOperation
+
+	// BatchID identifies the AIPs stored by StartBatch, which the workflow
+	// loads a page at a time. Total is their number and Offset the position
+	// of the next one to start, carried over when continuing as new.
+	BatchID string
+	Total   int
+	Offset  int
+
+	// UUIDs lists the AIPs of batches submitted before they were stored in
+	// the database.
+	UUIDs []uuid.UUID
+
+	MaxConcurrent int
+
+	// Settings passed to every AIP workflow of the batch.
+	Settings *WorkflowSettings
+
+	// Counters carried over from previous runs when continuing as new.
+	Completed int
+	Failed    int
+	Skipped   int
+	Cancelled int
+
+	// Paused is carried over so a paused batch stays paused after continuing
+	// as new.
+	Paused bool
+}
+
+type BatchWorkflowResult struct {
+	Completed int
+	Failed    int
+	Skipped   int
+	Cancelled int
+}
+
+const BatchWorkflowName = "batch-workflow"
+
+// BatchWorkflow starts one MoveWorkflow, ReplicateWorkflow or PipelineWorkflow
+// per AIP as child workflows, keeping at most MaxConcurrent of them running at
+// the same time.
+//
+// The pause, resume and cancel signals are forwarded to the running children.
+// While paused no new children are started, and after a cancel the AIPs not
+// started yet are marked as cancelled.
+type BatchWorkflow struct {
+	App *App
+
+	// childrenPerRun and pageSize default to batchChildrenPerRun and
+	// batchPageSize.
+	childrenPerRun int
+	pageSize       int
+}
+
+func NewBatchWorkflow(app *App) *BatchWorkflow {
+	return &BatchWorkflow{App: app, childrenPerRun: batchChildrenPerRun, pageSize: batchPageSize}
+}
+
+func (w *BatchWorkflow) Run(ctx workflow.Context, params BatchWorkflowParams) (*BatchWorkflowResult, error) {
+	logger := workflow.GetLogger(ctx)
+	result := &BatchWorkflowResult{
+		Completed: params.Completed,
+		Failed:    params.Failed,
+		Skipped:   params.Skipped,
+		Cancelled: params.Cancelled,
+	}
+
+	workflowName, err := params.Operation.workflowName()
+	if err != nil {
+		return nil, temporal.NewNonRetryableApplicationError(err.Error(), "InvalidBatchOperation", err)
+	}
+	maxConcurrent := max(params.MaxConcurrent, 1)
+
+	// Children in flight, in the order they were started.
+	var running []workflow.ChildWorkflowFuture
+	control := newWorkflowControl(ctx, params.Paused, func(name string) {
+		for _, child := range running {
+			// Signalling blocks until the child has started.
+			workflow.Go(ctx, func(ctx workflow.Context) {
+				if err := child.SignalChildWorkflow(ctx, name, nil).Get(ctx, nil); err != nil {
+					logger.Warn("Failed to forward signal to AIP workflow.", "signal", name, "error", err)
+				}
+			})
+		}
+	})
+
+	aips := newBatchAIPs(params, w.pageSize)
+	selector := workflow.NewSelector(ctx)
+	inFlight, started := 0, 0
+	for {
+		// Stop starting children once this run has done its share, then drain
+		// the in-flight ones before continuing as new.
+		for inFlight < maxConcurrent && aips.more() && started < w.childrenPerRun && !control.paused && !control.cancelled && !workflow.GetInfo(ctx).GetContinueAsNewSuggested() {
+			id, err := aips.next(ctx)
+			if err != nil {
+				return nil, err
+			}
+			started++
+
+			childCtx := workflow.WithChildOptions(ctx, workflow.ChildWorkflowOptions{
+				WorkflowID:            params.Operation.ChildWorkflowID(id),
+				WorkflowIDReusePolicy: enums.WORKFLOW_ID_REUSE_POLICY_ALLOW_DUPLICATE_FAILED_ONLY,
+			})
+			child := workflow.ExecuteChildWorkflow(childCtx, workflowName, params.Operation.childParams(id, params.Settings))
+			inFlight++
+			running = append(running, child)
+			selector.AddFuture(child, func(f workflow.Future) {
+				inFlight--
+				running = slices.DeleteFunc(running, func(f workflow.ChildWorkflowFuture) bool { return f == child })
+				var childResult struct{ Cancelled bool }
+				err := f.Get(ctx, &childResult)
+				switch {
+				case err == nil && childResult.Cancelled:
+					result.Cancelled++
+				case err == nil:
+					result.Completed++
+				case temporal.IsWorkflowExecutionAlreadyStartedError(err):
+					logger.Info("AIP workflow already running or completed, skipping.", "UUID", id.String())
+					result.Skipped++
+				default:
+					logger.Error("AIP workflow failed.", "UUID", id.String(), "error", err)
+					result.Failed++
+				}
+			})
+		}
+		if inFlight > 0 {
+			selector.Select(ctx)
+			continue
+		}
+		// Nothing in flight: wait here while paused, unless cancelled.
+		if control.paused && !control.cancelled && aips.more() {
+			if _, err := control.checkpoint(ctx); err != nil {
+				return nil, err
+			}
+			continue
+		}
+		break
+	}
+
+	if control.cancelled {
+		if err := aips.cancelRemaining(ctx); err != nil {
+			return nil, err
+		}
+		result.Cancelled += aips.remaining()
+		return result, nil
+	}
+
+	if aips.more() {
+		if params.BatchID != "" {
+			params.Offset = aips.offset
+		} else {
+			params.UUIDs = params.UUIDs[aips.offset:]
+		}
+		params.Completed = result.Completed
+		params.Failed = result.Failed
+		params.Skipped = result.Skipped
+		params.Cancelled = result.Cancelled
+		params.Paused = control.paused
+		return nil, workflow.NewContinueAsNewError(ctx, BatchWorkflowName, params)
+	}
+
+	return result, nil
+}
+
+// ChildWorkflowID returns the workflow ID used for the AIP, which is the same
+// one the CLI used when it started workflows one at a time.
+func (op BatchOperation) ChildWorkflowID(id uuid.UUID) string {
+	switch op {
+	case BatchOperationMove:
+		return fmt.Sprintf("AIP_Move_%s", id.String())
+	case BatchOperationPipeline:
+		return fmt.Sprintf("AIP_Pipeline_%s", id.String())
+	default:
+		return fmt.Sprintf("AIP_Replicate_%s", id.String())
+	}
+}
+
+func (op BatchOperation) workflowName() (string, error) {
+	switch op {
+	case BatchOperationMove:
+		return MoveWorkflowName, nil
+	case BatchOperationReplicate:
+		return ReplicateWorkflowName, nil
+	case BatchOperationPipeline:
+		return PipelineWorkflowName, nil
+	default:
+		return "", fmt.Errorf("unsupported batch operation %q", op)
+	}
+}
+
+func (op BatchOperation) childParams(id uuid.UUID, settings *WorkflowSettings) any {
+	switch op {
+	case BatchOperationMove:
+		return MoveWorkflowParams{UUID: id, Settings: settings}
+	case BatchOperationPipeline:
+		return PipelineWorkflowParams{UUID: id, Settings: settings}
+	default:
+		return ReplicateWorkflowParams{UUID: id, Settings: settings}
+	}
+}
+
+// batchAIPs iterates over the AIPs of a batch workflow run, loading them from
+// the database a page at a time. Batches submitted before the AIPs were stored
+// iterate over params.UUIDs instead.
+type batchAIPs struct {
+	batchID  string
+	pageSize int
+	total    int
+
+	// offset is the position of the next AIP, and page holds the AIPs loaded
+	// from position start on.
+	offset int
+	start  int
+	page   []uuid.UUID
+}
+
+func newBatchAIPs(params BatchWorkflowParams, pageSize int) *batchAIPs {
+	if params.BatchID == "" {
+		return &batchAIPs{total: len(params.UUIDs), page: params.UUIDs}
+	}
+	return &batchAIPs{
+		batchID:  params.BatchID,
+		pageSize: pageSize,
+		total:    params.Total,
+		offset:   params.Offset,
+		start:    params.Offset,
+	}
+}
+
+func (b *batchAIPs) more() bool {
+	return b.offset < b.total
+}
+
+func (b *batchAIPs) remaining() int {
+	return b.total - b.offset
+}
+
+// next returns the next AIP, loading the next page when needed.
+func (b *batchAIPs) next(ctx workflow.Context) (uuid.UUID, error) {
+	if b.offset-b.start >= len(b.page) {
+		ctx = workflow.WithActivityOptions(ctx, workflow.ActivityOptions{
+			StartToCloseTimeout: time.Minute,
+			RetryPolicy: &temporal.RetryPolicy{
+				MaximumAttempts: 5,
+			},
+		})
+		params := LoadBatchAIPsParams{BatchID: b.batchID, Offset: b.offset, Limit: b.pageSize}
+		var res LoadBatchAIPsResult
+		if err := workflow.ExecuteActivity(ctx, LoadBatchAIPsName, params).Get(ctx, &res); err != nil {
+			return uuid.Nil, err
+		}
+		if len(res.UUIDs) == 0 {
+			return uuid.Nil, temporal.NewNonRetryableApplicationError("batch AIPs missing from the database", "MissingBatchAIPs", nil)
+		}
+		b.start, b.page = b.offset, res.UUIDs
+	}
+	id := b.page[b.offset-b.start]
+	b.offset++
+	return id, nil
+}
+
+// cancelRemaining records the cancelled status for the AIPs not started yet.
+func (b *batchAIPs) cancelRemaining(ctx workflow.Context) error {
+	if !b.more() {
+		return nil
+	}
+	if b.batchID != "" {
+		return cancelBatchAIPs(ctx, b.batchID, b.offset)
+	}
+	remaining := make([]string, 0, b.remaining())
+	for _, id := range b.page[b.offset:] {
+		remaining = append(remaining, id.String())
+	}
+	return cancelAIPs(ctx, remaining...)
+}
+
+// StartBatch stores the given AIPs and submits a batch workflow for them,
+// returning without waiting for it to complete.
+func (a *App) StartBatch(ctx context.Context, op BatchOperation, uuids []uuid.UUID, maxConcurrent int) (client.WorkflowRun, error) {
+	if maxConcurrent <= 0 {
+		maxConcurrent = a.Config.Workflows.Batch.MaxConcurrent
+	}
+	options := client.StartWorkflowOptions{
+		ID:        fmt.Sprintf("Batch_%s_%s", op, uuid.NewString()),
+		TaskQueue: a.Config.Temporal.TaskQueue,
+	}
+	if err := a.storeBatchAIPs(ctx, options.ID, uuids); err != nil {
+		return nil, err
+	}
+	params := BatchWorkflowParams{
+		Operation:     op,
+		BatchID:       options.ID,
+		Total:         len(uuids),
+		MaxConcurrent: maxConcurrent,
+		Settings:      a.Config.WorkflowSettings(),
+	}
+	run, err := a.Tc.ExecuteWorkflow(ctx, options, BatchWorkflowName, params)
+	if err != nil {
+		_, deleteErr := models.BatchWorkflowAips.Delete(
+			models.DeleteWhere.BatchWorkflowAips.BatchID.EQ(options.ID),
+		).Exec(ctx, a.DB)
+		return nil, errors.Join(err, deleteErr)
+	}
+	return run, nil
+}
+
+// storeBatchAIPs records the AIPs of the batch workflow in submission order.
+func (a *App) storeBatchAIPs(ctx context.Context, batchID string, uuids []uuid.UUID) error {
+	err := a.DB.RunInTx(ctx, nil, func(ctx context.Context, exec bob.Executor) error {
+		for start := 0; start < len(uuids); start += batchPageSize {
+			end := min(start+batchPageSize, len(uuids))
+			setters := make([]*models.BatchWorkflowAipSetter, 0, end-start)
+			for i, id := range uuids[start:end] {
+				setters = append(setters, &models.BatchWorkflowAipSetter{
+					BatchID:  omit.From(batchID),
+					Position: omit.From(int64(start + i)),
+					AipUUID:  omit.From(id.String()),
+				})
+			}
+			if _, err := models.BatchWorkflowAips.Insert(bob.ToMods(setters...)).Exec(ctx, exec); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return fmt.Errorf("store batch AIPs: %w", err)
+	}
+	return nil
+}
+
+type LoadBatchAIPsParams struct {
+	BatchID string
+	Offset  int
+	Limit   int
+}
+
+type LoadBatchAIPsResult struct {
+	UUIDs []uuid.UUID
+}
+
+const LoadBatchAIPsName = "load-batch-aips"
+
+// LoadBatchAIPs returns a page of the AIPs stored for a batch workflow, from
+// position Offset on.
+func (a *App) LoadBatchAIPs(ctx context.Context, params LoadBatchAIPsParams) (*LoadBatchAIPsResult, error) {
+	rows, err := models.BatchWorkflowAips.Query(
+		models.SelectWhere.BatchWorkflowAips.BatchID.EQ(params.BatchID),
+		models.SelectWhere.BatchWorkflowAips.Position.GTE(int64(params.Offset)),
+		sm.OrderBy(models.BatchWorkflowAips.Columns.Position),
+		sm.Limit(params.Limit),
+	).All(ctx, a.DB)
+	if err != nil {
+		return nil, fmt.Errorf("load batch AIPs: %w", err)
+	}
+	result := &LoadBatchAIPsResult{UUIDs: make([]uuid.UUID, len(rows))}
+	for i, row := range rows {
+		if result.UUIDs[i], err = uuid.Parse(row.AipUUID); err != nil {
+			return nil, fmt.Errorf("parse AIP UUID: %w", err)
+		}
+	}
+	return result, nil
+}
+
+// SignalBatch sends one of the control signals to a running batch workflow.
+func (a *App) SignalBatch(ctx context.Context, batchID, signal string) error {
+	return a.Tc.SignalWorkflow(ctx, batchID, "", signal, nil)
+}
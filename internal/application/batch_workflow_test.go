@@ -0,0 +1,241 @@
+package application
+
+import (
+	"errors"
+	"slices"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/aarondl/opt/omit"
+	"github.com/google/uuid"
+	"go.temporal.io/sdk/activity"
+	"go.temporal.io/sdk/converter"
+	"go.temporal.io/sdk/testsuite"
+	"go.temporal.io/sdk/workflow"
+	"gotest.tools/v3/assert"
+
+	"github.com/artefactual-labs/migrate/internal/database/gen/models"
+)
+
+func TestBatchWorkflow(t *testing.T) {
+	t.Parallel()
+
+	uuids := []uuid.UUID{
+		uuid.MustParse("2faa61dc-ed33-49f4-8b36-954f203bab4a"),
+		uuid.MustParse("6e1076b3-e79c-49c8-bbf5-850963596b3c"),
+		uuid.MustParse("d4e6ba58-5bbc-4d2f-a11c-52f01f5a0e6c"),
+		uuid.MustParse("c4c0e3e5-7b8a-4d8a-9a43-0c2b38c1ee0f"),
+		uuid.MustParse("71cb2196-5629-4225-aaf7-d8431b0895c4"),
+	}
+	const batchID = "Batch_move_test"
+
+	// moves records the AIP workflows started, when they started and the most
+	// that ran at the same time.
+	type moves struct {
+		mu        sync.Mutex
+		started   []uuid.UUID
+		startedAt []time.Time
+		running   int
+		peak      int
+	}
+
+	setup := func(t *testing.T, w *BatchWorkflow) (*testsuite.TestWorkflowEnvironment, *moves) {
+		app := newTestApp(t)
+		assert.NilError(t, app.storeBatchAIPs(t.Context(), batchID, uuids))
+		for _, id := range uuids {
+			_, err := models.Aips.Insert(&models.AipSetter{
+				UUID:   omit.From(id.String()),
+				Status: omit.From(string(AIPStatusNew)),
+			}).Exec(t.Context(), app.DB)
+			assert.NilError(t, err)
+		}
+		w.App = app
+
+		var suite testsuite.WorkflowTestSuite
+		env := suite.NewTestWorkflowEnvironment()
+		env.RegisterWorkflowWithOptions(w.Run, workflow.RegisterOptions{Name: BatchWorkflowName})
+		env.RegisterActivityWithOptions(app.LoadBatchAIPs, activity.RegisterOptions{Name: LoadBatchAIPsName})
+		env.RegisterActivityWithOptions(app.CancelAIPs, activity.RegisterOptions{Name: CancelAIPsName})
+
+		m := &moves{}
+		env.RegisterWorkflowWithOptions(func(ctx workflow.Context, params MoveWorkflowParams) (*MoveWorkflowResult, error) {
+			m.mu.Lock()
+			m.started = append(m.started, params.UUID)
+			m.startedAt = append(m.startedAt, workflow.Now(ctx))
+			m.running++
+			m.peak = max(m.peak, m.running)
+			m.mu.Unlock()
+
+			err := workflow.Sleep(ctx, time.Hour)
+
+			m.mu.Lock()
+			m.running--
+			m.mu.Unlock()
+			return &MoveWorkflowResult{}, err
+		}, workflow.RegisterOptions{Name: MoveWorkflowName})
+
+		return env, m
+	}
+
+	params := BatchWorkflowParams{
+		Operation:     BatchOperationMove,
+		BatchID:       batchID,
+		Total:         len(uuids),
+		MaxConcurrent: 2,
+	}
+
+	t.Run("Bounds the AIP workflows running at the same time", func(t *testing.T) {
+		t.Parallel()
+
+		env, m := setup(t, &BatchWorkflow{childrenPerRun: batchChildrenPerRun, pageSize: 2})
+		env.ExecuteWorkflow(BatchWorkflowName, params)
+		assert.Assert(t, env.IsWorkflowCompleted())
+		assert.NilError(t, env.GetWorkflowError())
+
+		var result BatchWorkflowResult
+		assert.NilError(t, env.GetWorkflowResult(&result))
+		assert.DeepEqual(t, result, BatchWorkflowResult{Completed: 5})
+		assert.DeepEqual(t, m.started, uuids)
+		assert.Equal(t, m.peak, 2)
+	})
+
+	t.Run("Continues as new after starting its share of AIPs", func(t *testing.T) {
+		t.Parallel()
+
+		env, m := setup(t, &BatchWorkflow{childrenPerRun: 3, pageSize: 2})
+		env.ExecuteWorkflow(BatchWorkflowName, params)
+		assert.Assert(t, env.IsWorkflowCompleted())
+		assert.DeepEqual(t, m.started, uuids[:3])
+
+		var continued *workflow.ContinueAsNewError
+		assert.Assert(t, errors.As(env.GetWorkflowError(), &continued))
+		assert.Equal(t, continued.WorkflowType.Name, BatchWorkflowName)
+
+		var next BatchWorkflowParams
+		assert.NilError(t, converter.GetDefaultDataConverter().FromPayloads(continued.Input, &next))
+		assert.Equal(t, next.BatchID, batchID)
+		assert.Equal(t, next.Offset, 3)
+		assert.Equal(t, next.Completed, 3)
+		assert.Assert(t, next.UUIDs == nil)
+	})
+
+	t.Run("Resumes from the offset carried over", func(t *testing.T) {
+		t.Parallel()
+
+		env, m := setup(t, &BatchWorkflow{childrenPerRun: 3, pageSize: 2})
+		resumed := params
+		resumed.Offset, resumed.Completed = 3, 3
+		env.ExecuteWorkflow(BatchWorkflowName, resumed)
+		assert.Assert(t, env.IsWorkflowCompleted())
+		assert.NilError(t, env.GetWorkflowError())
+
+		var result BatchWorkflowResult
+		assert.NilError(t, env.GetWorkflowResult(&result))
+		assert.DeepEqual(t, result, BatchWorkflowResult{Completed: 5})
+		assert.DeepEqual(t, m.started, uuids[3:])
+	})
+
+	t.Run("Starts the AIPs listed in the parameters of older batches", func(t *testing.T) {
+		t.Parallel()
+
+		// Listed in another order than the one stored, which is not used.
+		listed := slices.Clone(uuids)
+		slices.Reverse(listed)
+
+		env, m := setup(t, &BatchWorkflow{childrenPerRun: 3, pageSize: 2})
+		env.ExecuteWorkflow(BatchWorkflowName, BatchWorkflowParams{
+			Operation:     BatchOperationMove,
+			UUIDs:         listed,
+			MaxConcurrent: 2,
+		})
+		assert.Assert(t, env.IsWorkflowCompleted())
+		assert.DeepEqual(t, m.started, listed[:3])
+
+		var continued *workflow.ContinueAsNewError
+		assert.Assert(t, errors.As(env.GetWorkflowError(), &continued))
+		var next BatchWorkflowParams
+		assert.NilError(t, converter.GetDefaultDataConverter().FromPayloads(continued.Input, &next))
+		assert.DeepEqual(t, next.UUIDs, listed[3:])
+	})
+
+	t.Run("Starts no AIP workflows while paused", func(t *testing.T) {
+		t.Parallel()
+
+		env, m := setup(t, &BatchWorkflow{childrenPerRun: batchChildrenPerRun, pageSize: 2})
+		start := env.Now()
+		env.RegisterDelayedCallback(func() {
+			env.SignalWorkflow(PauseSignalName, nil)
+		}, time.Minute)
+		env.RegisterDelayedCallback(func() {
+			env.SignalWorkflow(ResumeSignalName, nil)
+		}, 5*time.Hour)
+		env.ExecuteWorkflow(BatchWorkflowName, params)
+		assert.Assert(t, env.IsWorkflowCompleted())
+		assert.NilError(t, env.GetWorkflowError())
+
+		var result BatchWorkflowResult
+		assert.NilError(t, env.GetWorkflowResult(&result))
+		assert.DeepEqual(t, result, BatchWorkflowResult{Completed: 5})
+		assert.DeepEqual(t, m.started, uuids)
+		for i, at := range m.startedAt {
+			if i < 2 {
+				assert.Equal(t, at.Sub(start), time.Duration(0))
+			} else {
+				assert.Assert(t, at.Sub(start) >= 5*time.Hour, "AIP %d started after %s", i, at.Sub(start))
+			}
+		}
+	})
+
+	t.Run("Cancels the AIPs not started", func(t *testing.T) {
+		t.Parallel()
+
+		w := &BatchWorkflow{childrenPerRun: batchChildrenPerRun, pageSize: 2}
+		env, m := setup(t, w)
+		// An AIP not started by this batch but finished earlier keeps its
+		// status.
+		assert.NilError(t, w.App.UpdateAIPStatus(t.Context(), getTestAIP(t, w.App, uuids[3].String()).ID, AIPStatusMoved))
+		env.RegisterDelayedCallback(func() {
+			env.SignalWorkflow(CancelSignalName, nil)
+		}, time.Minute)
+		env.ExecuteWorkflow(BatchWorkflowName, params)
+		assert.Assert(t, env.IsWorkflowCompleted())
+		assert.NilError(t, env.GetWorkflowError())
+
+		var result BatchWorkflowResult
+		assert.NilError(t, env.GetWorkflowResult(&result))
+		assert.DeepEqual(t, result, BatchWorkflowResult{Completed: 2, Cancelled: 3})
+		assert.DeepEqual(t, m.started, uuids[:2])
+
+		for i, id := range uuids {
+			want := AIPStatusNew
+			switch {
+			case i == 3:
+				want = AIPStatusMoved
+			case i >= 2:
+				want = AIPStatusCancelled
+			}
+			assert.Equal(t, getTestAIP(t, w.App, id.String()).Status, string(want))
+		}
+	})
+}
+
+func TestLoadBatchAIPs(t *testing.T) {
+	t.Parallel()
+
+	app := newTestApp(t)
+	uuids := make([]uuid.UUID, 250)
+	for i := range uuids {
+		uuids[i] = uuid.New()
+	}
+	assert.NilError(t, app.storeBatchAIPs(t.Context(), "batch-1", uuids))
+	assert.NilError(t, app.storeBatchAIPs(t.Context(), "batch-2", uuids[:1]))
+
+	res, err := app.LoadBatchAIPs(t.Context(), LoadBatchAIPsParams{BatchID: "batch-1", Offset: 90, Limit: 20})
+	assert.NilError(t, err)
+	assert.DeepEqual(t, res.UUIDs, uuids[90:110])
+
+	res, err = app.LoadBatchAIPs(t.Context(), LoadBatchAIPsParams{BatchID: "batch-1", Offset: 240, Limit: 20})
+	assert.NilError(t, err)
+	assert.DeepEqual(t, res.UUIDs, uuids[240:])
+}
@@ -0,0 +1,267 @@
+package application
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+
+	"go.temporal.io/sdk/workflow"
+
+	"github.com/artefactual-labs/migrate/internal/database/gen/models"
+	"github.com/artefactual-labs/migrate/internal/storage_service"
+)
+
+const CleanupActivityName = "cleanup-aip"
+
+type CleanupActivityParams struct {
+	UUID    string
+	Cleanup WorkflowCleanupConfig
+
+	// DeleteOriginal is set once every replica of the AIP has been verified,
+	// which allows requesting the deletion of the original package.
+	DeleteOriginal bool
+
+	// Settings of the workflow, nil for runs submitted before they were
+	// passed to the activity.
+	Settings *WorkflowSettings
+}
+
+type CleanupActivityResult struct {
+	// Deleted lists the artefacts deleted, or the ones that would have been
+	// deleted in a dry run.
+	Deleted []string
+	DryRun  bool
+
+	// Kept explains why the original package was not deleted.
+	Kept []string
+}
+
+// CleanupA deletes the copies of the AIP found in the leftover directories and,
+// when allowed, asks the Storage Service to delete the original package. Each
+// artefact is recorded in its own event. The copies the Storage Service knows
+// about, i.e. the AIP and its replicas, are never deleted from disk.
+func (a *App) CleanupA(ctx context.Context, params CleanupActivityParams) (*CleanupActivityResult, error) {
+	aip, err := a.GetAIPByID(ctx, params.UUID)
+	if err != nil {
+		return nil, err
+	}
+
+	pkg, err := a.StorageClient.Packages.GetByID(ctx, params.UUID)
+	if err != nil {
+		return nil, err
+	}
+	keep := []string{params.Cleanup.workerPath(pkg.CurrentFullPath)}
+	replicas := make([]*storage_service.Package, 0, len(pkg.Replicas))
+	for _, uri := range pkg.Replicas {
+		replica, err := a.StorageClient.Packages.GetByID(ctx, storage_service.ResourceUUID(uri))
+		if err != nil {
+			return nil, err
+		}
+		replicas = append(replicas, replica)
+		keep = append(keep, params.Cleanup.workerPath(replica.CurrentFullPath))
+	}
+
+	paths, err := leftoverPaths(params.Cleanup.LeftoverDirs, params.UUID, keep)
+	if err != nil {
+		return nil, err
+	}
+
+	dryRun := params.Cleanup.DryRun
+	result := &CleanupActivityResult{DryRun: dryRun}
+	for _, path := range paths {
+		e := StartEvent(ActionCleanup)
+		e.AddDetail("Deleting: " + path)
+		if dryRun {
+			e.AddDetail("Dry run, not deleted")
+		} else if err := os.RemoveAll(path); err != nil {
+			if eventErr := EndEventErrNoFailure(ctx, a, e, aip, err.Error()); eventErr != nil {
+				return nil, errors.Join(err, eventErr)
+			}
+			return nil, fmt.Errorf("delete %s: %w", path, err)
+		}
+		if err := EndEventNoChange(ctx, a, e, aip); err != nil {
+			return nil, err
+		}
+		result.Deleted = append(result.Deleted, path)
+	}
+
+	if params.DeleteOriginal && params.Cleanup.DeleteOriginal.Enabled && pkg.Status != "DEL_REQ" {
+		if err := a.requestOriginalDeletion(ctx, params, aip, pkg, replicas, result); err != nil {
+			return nil, err
+		}
+	}
+
+	// The AIP is only cleaned once something has been deleted.
+	if !dryRun && len(result.Deleted) > 0 {
+		if err := a.UpdateAIPStatus(ctx, aip.ID, AIPStatusCleaned); err != nil {
+			return nil, err
+		}
+	}
+
+	return result, nil
+}
+
+// requestOriginalDeletion asks the Storage Service to delete the original
+// package, unless keepOriginal gives a reason to keep it.
+func (a *App) requestOriginalDeletion(ctx context.Context, params CleanupActivityParams, aip *models.Aip, pkg *storage_service.Package, replicas []*storage_service.Package, result *CleanupActivityResult) error {
+	locations := a.workflowSettings(params.Settings).Locations.forAIP(aip)
+	reason, err := a.keepOriginal(ctx, aip, pkg, replicas, locations)
+	if err != nil {
+		return err
+	}
+
+	e := StartEvent(ActionCleanup)
+	if reason != "" {
+		kept := fmt.Sprintf("package %s, %s", pkg.UUID, reason)
+		e.AddDetail("Not requesting deletion of " + kept)
+		if err := EndEventNoChange(ctx, a, e, aip); err != nil {
+			return err
+		}
+		result.Kept = append(result.Kept, kept)
+		return nil
+	}
+
+	e.AddDetail("Requesting deletion of package: " + pkg.UUID)
+	if result.DryRun {
+		e.AddDetail("Dry run, not requested")
+	} else {
+		deleteOriginal := params.Cleanup.DeleteOriginal
+		err := a.StorageClient.Packages.RequestDeletion(ctx, pkg.UUID, storage_service.DeletionRequest{
+			EventReason: deleteOriginal.Reason,
+			Pipeline:    storage_service.ResourceUUID(pkg.OriginPipeline),
+			UserID:      deleteOriginal.UserID,
+			UserEmail:   deleteOriginal.UserEmail,
+		})
+		if err != nil {
+			if eventErr := EndEventErrNoFailure(ctx, a, e, aip, err.Error()); eventErr != nil {
+				return errors.Join(err, eventErr)
+			}
+			return fmt.Errorf("request deletion: %w", err)
+		}
+	}
+	if err := EndEventNoChange(ctx, a, e, aip); err != nil {
+		return err
+	}
+	result.Deleted = append(result.Deleted, "package "+pkg.UUID)
+	return nil
+}
+
+// keepOriginal returns why the deletion of the package must not be requested,
+// or an empty string when it can be. Only the package left in the source
+// location of the AIP is deleted, never the one in the move target, and only
+// once a replica in another location is stored and has passed the fixity
+// check.
+func (a *App) keepOriginal(ctx context.Context, aip *models.Aip, pkg *storage_service.Package, replicas []*storage_service.Package, locations StorageServiceLocationConfig) (string, error) {
+	location := storage_service.ResourceUUID(pkg.CurrentLocation)
+	switch {
+	case pkg.Status != "UPLOADED":
+		return "its status is " + pkg.Status, nil
+	case locations.SourceLocationID == "":
+		return "the source location of the AIP is unknown", nil
+	case location == locations.MoveTargetLocationID:
+		return "it has been moved to " + location, nil
+	case location != locations.SourceLocationID:
+		return "it is not stored in the source location " + locations.SourceLocationID, nil
+	}
+
+	verified, err := aip.AipReplications(
+		models.SelectWhere.AipReplications.Status.EQ(string(AIPReplicationStatusFinished)),
+		models.SelectWhere.AipReplications.FixityStatus.EQ(string(ReplicaFixityStatusPassed)),
+	).All(ctx, a.DB)
+	if err != nil {
+		return "", err
+	}
+	for _, r := range replicas {
+		if r.UUID == pkg.UUID || r.Status != "UPLOADED" || storage_service.ResourceUUID(r.CurrentLocation) == location {
+			continue
+		}
+		if slices.ContainsFunc(verified, func(v *models.AipReplication) bool { return v.ReplicaUUID.GetOrZero() == r.UUID }) {
+			return "", nil
+		}
+	}
+	return "no replica has been verified", nil
+}
+
+// leftoverPaths returns the entries named after the AIP in dirs, looking both
+// at the top of each directory and in the UUID quad directories used by the
+// Storage Service, e.g. dir/1111/2222/.../name-UUID.7z. Paths within or
+// containing one of the keep paths are left out.
+func leftoverPaths(dirs []string, aipUUID string, keep []string) ([]string, error) {
+	quad := make([]string, 0, 8)
+	for s := strings.ReplaceAll(aipUUID, "-", ""); len(s) >= 4; s = s[4:] {
+		quad = append(quad, s[:4])
+	}
+
+	var paths []string
+	for _, dir := range dirs {
+		dir = filepath.Clean(dir)
+		for _, d := range []string{dir, filepath.Join(append([]string{dir}, quad...)...)} {
+			entries, err := os.ReadDir(d)
+			if errors.Is(err, fs.ErrNotExist) {
+				continue
+			} else if err != nil {
+				return nil, err
+			}
+			for _, entry := range entries {
+				if !strings.Contains(entry.Name(), aipUUID) {
+					continue
+				}
+				path := filepath.Join(d, entry.Name())
+				if !overlaps(path, keep) && !slices.Contains(paths, path) {
+					paths = append(paths, path)
+				}
+			}
+		}
+	}
+	return paths, nil
+}
+
+// overlaps reports whether path is one of the keep paths, is within one, or
+// contains one.
+func overlaps(path string, keep []string) bool {
+	for _, k := range keep {
+		if k == "" {
+			continue
+		}
+		k = filepath.Clean(k)
+		if path == k || strings.HasPrefix(path, k+string(filepath.Separator)) || strings.HasPrefix(k, path+string(filepath.Separator)) {
+			return true
+		}
+	}
+	return false
+}
+
+// cleanupAIP runs the cleanup step when it is enabled and returns its details.
+func cleanupAIP(ctx workflow.Context, settings *WorkflowSettings, aipUUID string, deleteOriginal bool) ([]string, error) {
+	cleanup := settings.Cleanup
+	if !cleanup.Enabled {
+		return nil, nil
+	}
+	if v := workflow.GetVersion(ctx, cleanupChangeID, workflow.DefaultVersion, 1); v == workflow.DefaultVersion {
+		return nil, nil
+	}
+
+	params := CleanupActivityParams{UUID: aipUUID, Cleanup: cleanup, DeleteOriginal: deleteOriginal, Settings: settings}
+	var res CleanupActivityResult
+	if err := executeActivity(ctx, cleanup.Activities, CleanupActivityName, params).Get(ctx, &res); err != nil {
+		return nil, err
+	}
+
+	prefix := "Cleaned: "
+	if res.DryRun {
+		prefix = "Cleanup dry run: "
+	}
+	details := make([]string, 0, len(res.Deleted)+len(res.Kept))
+	for _, d := range res.Deleted {
+		details = append(details, prefix+d)
+	}
+	for _, k := range res.Kept {
+		details = append(details, "Kept: "+k)
+	}
+	return details, nil
+}
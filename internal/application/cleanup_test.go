@@ -0,0 +1,266 @@
+package application
+
+import (
+	"encoding/json"
+	"net/http"
+	"os"
+	"path/filepath"
+	"sync/atomic"
+	"testing"
+
+	"github.com/aarondl/opt/omit"
+	"github.com/aarondl/opt/omitnull"
+	"go.temporal.io/sdk/activity"
+	"go.temporal.io/sdk/testsuite"
+	"gotest.tools/v3/assert"
+	"gotest.tools/v3/fs"
+
+	"github.com/artefactual-labs/migrate/internal/database/gen/models"
+	"github.com/artefactual-labs/migrate/internal/storage_service"
+)
+
+func TestLeftoverPaths(t *testing.T) {
+	t.Parallel()
+
+	const aipUUID = "6a9f0f33-2d1c-4c4e-9a5a-6c1d2b3e4f50"
+	old := fs.NewDir(t, "old",
+		fs.WithFile("aip-"+aipUUID+".7z", ""),
+		fs.WithFile("other-8b3b1c1e-6f0a-4d55-b0f5-2f3f7e6c9a10.7z", ""),
+		fs.WithDir("6a9f/0f33/2d1c/4c4e/9a5a/6c1d/2b3e/4f50",
+			fs.WithDir("aip-"+aipUUID),
+		),
+	)
+	current := fs.NewDir(t, "current",
+		fs.WithDir("6a9f/0f33/2d1c/4c4e/9a5a/6c1d/2b3e/4f50",
+			fs.WithFile("aip-"+aipUUID+".7z", ""),
+		),
+	)
+	currentPath := filepath.Join(current.Path(), "6a9f/0f33/2d1c/4c4e/9a5a/6c1d/2b3e/4f50", "aip-"+aipUUID+".7z")
+
+	paths, err := leftoverPaths([]string{old.Path(), current.Path(), filepath.Join(t.TempDir(), "missing")}, aipUUID, []string{currentPath})
+	assert.NilError(t, err)
+	assert.DeepEqual(t, paths, []string{
+		filepath.Join(old.Path(), "aip-"+aipUUID+".7z"),
+		filepath.Join(old.Path(), "6a9f/0f33/2d1c/4c4e/9a5a/6c1d/2b3e/4f50", "aip-"+aipUUID),
+	})
+}
+
+func TestCleanupWorkerPath(t *testing.T) {
+	t.Parallel()
+
+	cfg := WorkflowCleanupConfig{PathMappings: []CleanupPathMapping{
+		{StorageService: "/var/archivematica", Worker: "/mnt/am"},
+		{StorageService: "/var/archivematica/storage/", Worker: "/mnt/storage"},
+	}}
+	for _, tc := range []struct {
+		name string
+		path string
+		want string
+	}{
+		{name: "Unmapped", path: "/srv/aips/aip.7z", want: "/srv/aips/aip.7z"},
+		{name: "Mapped", path: "/var/archivematica/shared/aip.7z", want: "/mnt/am/shared/aip.7z"},
+		{name: "Longest mapping", path: "/var/archivematica/storage/1234/aip.7z", want: "/mnt/storage/1234/aip.7z"},
+		{name: "Mapped directory", path: "/var/archivematica/storage", want: "/mnt/storage"},
+		{name: "Sibling directory", path: "/var/archivematica-old/aip.7z", want: "/var/archivematica-old/aip.7z"},
+		{name: "Empty", path: "", want: ""},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			assert.Equal(t, cfg.workerPath(tc.path), tc.want)
+		})
+	}
+}
+
+func TestCleanupA(t *testing.T) {
+	t.Parallel()
+
+	const (
+		aipUUID     = "6a9f0f33-2d1c-4c4e-9a5a-6c1d2b3e4f50"
+		replicaUUID = "3c5e7a9b-1d2f-4a6b-8c0e-2f4a6c8e0b1d"
+		source      = "source-location"
+		target      = "target-location"
+		replicas    = "replica-location"
+		quad        = "6a9f/0f33/2d1c/4c4e/9a5a/6c1d/2b3e/4f50"
+	)
+
+	type testCase struct {
+		// location is where the Storage Service stores the AIP.
+		location string
+		// replica is the status of the replica in the Storage Service, there
+		// is none when empty, and verified tells whether it passed the
+		// fixity check.
+		replica  string
+		verified bool
+		dryRun   bool
+		// noLeftovers leaves only the copy of the Storage Service in the
+		// leftover directory.
+		noLeftovers bool
+	}
+
+	// run runs CleanupA for an AIP whose copy, as seen by the Storage
+	// Service, is under /var/archivematica/old, mounted on the workers at
+	// the leftover directory. It returns the result, the leftover directory
+	// and the number of deletion requests filed.
+	run := func(t *testing.T, tc testCase) (*CleanupActivityResult, string, int32) {
+		app := newTestApp(t)
+		aip, err := models.Aips.Insert(&models.AipSetter{
+			UUID:   omit.From(aipUUID),
+			Status: omit.From(string(AIPStatusReplicated)),
+		}).One(t.Context(), app.DB)
+		assert.NilError(t, err)
+		replication := &models.AipReplicationSetter{
+			LocationUUID: omitnull.From(replicas),
+			Status:       omit.From(string(AIPReplicationStatusFinished)),
+			ReplicaUUID:  omitnull.From(replicaUUID),
+		}
+		if tc.verified {
+			replication.FixityStatus = omitnull.From(string(ReplicaFixityStatusPassed))
+		}
+		assert.NilError(t, aip.InsertAipReplications(t.Context(), app.DB, replication))
+
+		files := []fs.PathOp{fs.WithFile("aip-"+aipUUID+".7z", "")}
+		if !tc.noLeftovers {
+			files = append(files, fs.WithDir("aip-"+aipUUID))
+		}
+		old := fs.NewDir(t, "old", fs.WithDir(quad, files...))
+
+		pkg := storage_service.Package{
+			UUID:            aipUUID,
+			Status:          "UPLOADED",
+			CurrentLocation: "/api/v2/location/" + tc.location + "/",
+			CurrentFullPath: "/var/archivematica/old/" + quad + "/aip-" + aipUUID + ".7z",
+		}
+		if tc.replica != "" {
+			pkg.Replicas = []string{"/api/v2/file/" + replicaUUID + "/"}
+		}
+		var requests atomic.Int32
+		app.StorageClient = newTestStorageService(t, func(w http.ResponseWriter, r *http.Request) {
+			switch r.URL.Path {
+			case "/api/v2/file/" + aipUUID + "/":
+				_ = json.NewEncoder(w).Encode(pkg)
+			case "/api/v2/file/" + replicaUUID + "/":
+				_ = json.NewEncoder(w).Encode(storage_service.Package{
+					UUID:            replicaUUID,
+					Status:          tc.replica,
+					CurrentLocation: "/api/v2/location/" + replicas + "/",
+					CurrentFullPath: "/var/archivematica/replicas/aip-" + replicaUUID + ".7z",
+				})
+			case "/api/v2/file/" + aipUUID + "/delete_aip/":
+				requests.Add(1)
+				w.WriteHeader(http.StatusAccepted)
+				_, _ = w.Write([]byte("{}"))
+			default:
+				http.NotFound(w, r)
+			}
+		})
+
+		settings := app.Config.WorkflowSettings()
+		settings.Locations.SourceLocationID = source
+		settings.Locations.MoveTargetLocationID = target
+		cleanup := WorkflowCleanupConfig{
+			Enabled:      true,
+			DryRun:       tc.dryRun,
+			LeftoverDirs: []string{old.Path()},
+			PathMappings: []CleanupPathMapping{{StorageService: "/var/archivematica/old", Worker: old.Path()}},
+			DeleteOriginal: DeleteOriginalConfig{
+				Enabled:   true,
+				Reason:    "Replicated by migrate",
+				UserID:    1,
+				UserEmail: "admin@example.com",
+			},
+		}
+
+		var suite testsuite.WorkflowTestSuite
+		env := suite.NewTestActivityEnvironment()
+		env.RegisterActivityWithOptions(app.CleanupA, activity.RegisterOptions{Name: CleanupActivityName})
+		val, err := env.ExecuteActivity(CleanupActivityName, CleanupActivityParams{
+			UUID:           aipUUID,
+			Cleanup:        cleanup,
+			DeleteOriginal: true,
+			Settings:       settings,
+		})
+		assert.NilError(t, err)
+		var res CleanupActivityResult
+		assert.NilError(t, val.Get(&res))
+		// The AIP is cleaned once something has been deleted.
+		want := AIPStatusReplicated
+		if !tc.dryRun && len(res.Deleted) > 0 {
+			want = AIPStatusCleaned
+		}
+		aip = getTestAIP(t, app, aipUUID)
+		assert.Equal(t, aip.Status, string(want))
+		assert.Equal(t, aip.Cleaned, want == AIPStatusCleaned)
+		return &res, old.Path(), requests.Load()
+	}
+
+	t.Run("Deletes the leftovers and the original once a replica is verified", func(t *testing.T) {
+		t.Parallel()
+
+		res, dir, requests := run(t, testCase{location: source, replica: "UPLOADED", verified: true})
+		leftover := filepath.Join(dir, quad, "aip-"+aipUUID)
+		assert.DeepEqual(t, res.Deleted, []string{leftover, "package " + aipUUID})
+		assert.Equal(t, requests, int32(1))
+
+		// The copy the Storage Service uses, mapped to the leftover
+		// directory, is kept.
+		_, err := os.Stat(filepath.Join(dir, quad, "aip-"+aipUUID+".7z"))
+		assert.NilError(t, err)
+		_, err = os.Stat(leftover)
+		assert.Assert(t, os.IsNotExist(err))
+	})
+
+	t.Run("Leaves the AIP as is when nothing is deleted", func(t *testing.T) {
+		t.Parallel()
+
+		res, _, requests := run(t, testCase{location: target, replica: "UPLOADED", verified: true, noLeftovers: true})
+		assert.Equal(t, len(res.Deleted), 0)
+		assert.DeepEqual(t, res.Kept, []string{"package " + aipUUID + ", it has been moved to " + target})
+		assert.Equal(t, requests, int32(0))
+	})
+
+	t.Run("Deletes nothing in a dry run", func(t *testing.T) {
+		t.Parallel()
+
+		res, dir, requests := run(t, testCase{location: source, replica: "UPLOADED", verified: true, dryRun: true})
+		assert.Equal(t, len(res.Deleted), 2)
+		assert.Equal(t, requests, int32(0))
+		_, err := os.Stat(filepath.Join(dir, quad, "aip-"+aipUUID))
+		assert.NilError(t, err)
+	})
+
+	for name, tc := range map[string]struct {
+		testCase
+		kept string
+	}{
+		"Keeps a moved original": {
+			testCase: testCase{location: target, replica: "UPLOADED", verified: true},
+			kept:     "it has been moved to " + target,
+		},
+		"Keeps an original outside of the source location": {
+			testCase: testCase{location: "other-location", replica: "UPLOADED", verified: true},
+			kept:     "it is not stored in the source location " + source,
+		},
+		"Keeps the original without replicas": {
+			testCase: testCase{location: source},
+			kept:     "no replica has been verified",
+		},
+		"Keeps the original of an unverified replica": {
+			testCase: testCase{location: source, replica: "UPLOADED"},
+			kept:     "no replica has been verified",
+		},
+		"Keeps the original of a deleted replica": {
+			testCase: testCase{location: source, replica: "DELETED", verified: true},
+			kept:     "no replica has been verified",
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			res, dir, requests := run(t, tc.testCase)
+			assert.DeepEqual(t, res.Deleted, []string{filepath.Join(dir, quad, "aip-"+aipUUID)})
+			assert.DeepEqual(t, res.Kept, []string{"package " + aipUUID + ", " + tc.kept})
+			assert.Equal(t, requests, int32(0))
+		})
+	}
+}
@@ -1,12 +1,17 @@
 package application
 
 import (
+	"cmp"
 	"encoding/json"
 	"errors"
 	"fmt"
 	"io/fs"
 	"os"
 	"path/filepath"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
 
 	"github.com/tailscale/hujson"
 )
@@ -66,6 +71,30 @@ func normalizeConfig(cfg *Config) error {
 		return err
 	}
 
+	if cfg.Workflows.Batch.MaxConcurrent <= 0 {
+		cfg.Workflows.Batch.MaxConcurrent = 1
+	}
+	if err := cfg.Workflows.Pipeline.applyDefaults(); err != nil {
+		return err
+	}
+	if err := cfg.Workflows.Cleanup.validate(); err != nil {
+		return err
+	}
+	if err := cfg.Workflows.Move.Reindex.applyDefaults(); err != nil {
+		return err
+	}
+
+	if cfg.Workflows.Batch.Order == "" {
+		cfg.Workflows.Batch.Order = OrderInput
+	}
+	if err := ValidateOrder(cfg.Workflows.Batch.Order); err != nil {
+		return fmt.Errorf("invalid workflows.batch.order: %w", err)
+	}
+
+	if _, err := cfg.Schedule.windows(); err != nil {
+		return err
+	}
+
 	if cfg.Database.Engine == "" {
 		cfg.Database.Engine = "sqlite"
 	}
@@ -149,6 +178,22 @@ func DefaultConfig() *Config {
 			Address:   "127.0.0.1:7233",
 			TaskQueue: "default",
 		},
+		Workflows: WorkflowConfig{
+			Move: WorkflowMoveConfig{
+				Reindex: WorkflowReindexConfig{
+					Command:        slices.Clone(DefaultReindexCommand),
+					SuccessPattern: DefaultReindexSuccessPattern,
+					FailurePattern: DefaultReindexFailurePattern,
+				},
+			},
+			Pipeline: WorkflowPipelineConfig{
+				Steps: slices.Clone(DefaultPipelineSteps),
+			},
+			Batch: WorkflowBatchConfig{
+				MaxConcurrent: 1,
+				Order:         OrderInput,
+			},
+		},
 	}
 
 	_ = cfg.StorageService.applyDefaults()
@@ -177,6 +222,9 @@ type Config struct {
 
 	// Workflow-level configuration toggles.
 	Workflows WorkflowConfig `json:"workflows"`
+
+	// Maintenance windows during which moves and replications may start.
+	Schedule ScheduleConfig `json:"schedule"`
 }
 
 type TemporalConfig struct {
@@ -195,25 +243,10 @@ type StorageServiceConfig struct {
 }
 
 func (c *StorageServiceConfig) applyDefaults() error {
-	if c.Management.Mode == "" {
-		if c.Management.Docker.Container != "" {
-			c.Management.Mode = "docker"
-		} else {
-			c.Management.Mode = "host"
-		}
-	}
-
-	switch c.Management.Mode {
-	case "docker":
-	case "host":
-		if c.Management.Host.PythonPath == "" {
-			c.Management.Host.PythonPath = "python3"
-		}
-	default:
-		return fmt.Errorf("unsupported storage_service.management.mode %q", c.Management.Mode)
+	if err := c.Management.applyDefaults("storage_service.management"); err != nil {
+		return err
 	}
-
-	return nil
+	return c.Locations.validate()
 }
 
 type StorageServiceAPIConfig struct {
@@ -222,11 +255,64 @@ type StorageServiceAPIConfig struct {
 	APIKey   string `json:"api_key"`
 }
 
+// Modes of running the management commands.
+const (
+	ManagementModeDocker  = "docker"
+	ManagementModeHost    = "host"
+	ManagementModeKubectl = "kubectl"
+	ManagementModeSSH     = "ssh"
+)
+
 type StorageServiceManagementConfig struct {
+	// Mode selects the ManagementRunner: "docker", "host", "kubectl" or
+	// "ssh".
 	Mode string `json:"mode"`
 
-	Docker StorageServiceDockerConfig `json:"docker"`
-	Host   StorageServiceHostConfig   `json:"host"`
+	Docker  StorageServiceDockerConfig  `json:"docker"`
+	Host    StorageServiceHostConfig    `json:"host"`
+	Kubectl StorageServiceKubectlConfig `json:"kubectl"`
+	SSH     StorageServiceSSHConfig     `json:"ssh"`
+
+	// Timeout kills commands running for longer, zero means no timeout.
+	Timeout Duration `json:"timeout"`
+}
+
+// applyDefaults fills in the mode, the Python interpreter and the client
+// binaries. name is the configuration key used in error messages.
+func (c *StorageServiceManagementConfig) applyDefaults(name string) error {
+	if c.Mode == "" {
+		if c.Docker.Container != "" {
+			c.Mode = ManagementModeDocker
+		} else {
+			c.Mode = ManagementModeHost
+		}
+	}
+
+	switch c.Mode {
+	case ManagementModeDocker:
+	case ManagementModeHost:
+		if c.Host.PythonPath == "" {
+			c.Host.PythonPath = "python3"
+		}
+	case ManagementModeKubectl:
+		if c.Kubectl.KubectlPath == "" {
+			c.Kubectl.KubectlPath = "kubectl"
+		}
+	case ManagementModeSSH:
+		if c.SSH.SSHPath == "" {
+			c.SSH.SSHPath = "ssh"
+		}
+		if c.SSH.PythonPath == "" {
+			c.SSH.PythonPath = "python3"
+		}
+	default:
+		return fmt.Errorf("unsupported %s.mode %q", name, c.Mode)
+	}
+	if c.Timeout < 0 {
+		return fmt.Errorf("%s.timeout must not be negative", name)
+	}
+
+	return nil
 }
 
 type StorageServiceDockerConfig struct {
@@ -240,10 +326,106 @@ type StorageServiceHostConfig struct {
 	Environment map[string]string `json:"environment"`
 }
 
+// StorageServiceKubectlConfig runs the commands with kubectl exec.
+type StorageServiceKubectlConfig struct {
+	KubectlPath string `json:"kubectl_path"`
+	// Context and Namespace default to the ones of the kubeconfig.
+	Context   string `json:"context"`
+	Namespace string `json:"namespace"`
+	// Pod is a pod name or a resource such as
+	// "deployment/archivematica-storage-service".
+	Pod        string `json:"pod"`
+	Container  string `json:"container"`
+	ManagePath string `json:"manage_path"`
+}
+
+// StorageServiceSSHConfig runs the commands on another machine over SSH,
+// using the host keys and credentials of the ssh client.
+type StorageServiceSSHConfig struct {
+	SSHPath      string   `json:"ssh_path"`
+	Host         string   `json:"host"`
+	User         string   `json:"user"`
+	Port         int      `json:"port"`
+	IdentityFile string   `json:"identity_file"`
+	Options      []string `json:"options"`
+
+	PythonPath  string            `json:"python_path"`
+	ManagePath  string            `json:"manage_path"`
+	Environment map[string]string `json:"environment"`
+}
+
 type StorageServiceLocationConfig struct {
 	SourceLocationID     string              `json:"source_location_id"`
 	MoveTargetLocationID string              `json:"move_target_location_id"`
 	ReplicationTargets   []ReplicationTarget `json:"replication_targets"`
+
+	// Limits caps the number of moves and replications using a location at
+	// the same time, across all workers.
+	Limits []LocationLimit `json:"limits"`
+
+	// ReplicationRules selects the replication targets of each AIP from its
+	// package attributes. AIPs no exclusive rule matches are also replicated
+	// to ReplicationTargets.
+	ReplicationRules []ReplicationRule `json:"replication_rules"`
+
+	// Mappings lists the source locations of the migration with the move
+	// target and replication targets of their AIPs. Each AIP follows the
+	// mapping of the location the Storage Service reports it in. Without
+	// mappings, AIPs are moved from SourceLocationID to
+	// MoveTargetLocationID wherever they are stored.
+	Mappings []LocationMapping `json:"mappings"`
+}
+
+// LocationMapping sends the AIPs stored in SourceLocationID to
+// MoveTargetLocationID and ReplicationTargets. A mapping without replication
+// targets uses the top-level replication_targets.
+type LocationMapping struct {
+	SourceLocationID     string              `json:"source_location_id"`
+	MoveTargetLocationID string              `json:"move_target_location_id"`
+	ReplicationTargets   []ReplicationTarget `json:"replication_targets"`
+}
+
+func (c *StorageServiceLocationConfig) validate() error {
+	sources := map[string]struct{}{}
+	for i, m := range c.Mappings {
+		if m.SourceLocationID == "" {
+			return fmt.Errorf("storage_service.locations.mappings[%d].source_location_id is required", i)
+		}
+		if _, ok := sources[m.SourceLocationID]; ok {
+			return fmt.Errorf("storage_service.locations.mappings: duplicate source location %q", m.SourceLocationID)
+		}
+		sources[m.SourceLocationID] = struct{}{}
+	}
+
+	names := map[string]struct{}{DefaultReplicationRule: {}, InputReplicationRule: {}}
+	for i, r := range c.ReplicationRules {
+		if r.Name == "" {
+			return fmt.Errorf("storage_service.locations.replication_rules[%d].name is required", i)
+		}
+		if _, ok := names[r.Name]; ok {
+			return fmt.Errorf("storage_service.locations.replication_rules: duplicate or reserved name %q", r.Name)
+		}
+		names[r.Name] = struct{}{}
+		if len(r.Targets) == 0 {
+			return fmt.Errorf("storage_service.locations.replication_rules[%d].targets is required", i)
+		}
+		if r.Match.MaxSize != 0 && r.Match.MaxSize < r.Match.MinSize {
+			return fmt.Errorf("storage_service.locations.replication_rules[%d].match.max_size is smaller than min_size", i)
+		}
+	}
+	return nil
+}
+
+// LocationLimit sets the maximum number of concurrent moves or replications
+// that read from or write to a location, and how many bytes may be transferred
+// to or from it per day. When SourceMaxConcurrent is set, the transfers
+// reading from the location are limited by it instead, and MaxConcurrent only
+// limits those writing to it. Zero means unlimited.
+type LocationLimit struct {
+	LocationID          string   `json:"location_id"`
+	MaxConcurrent       int      `json:"max_concurrent"`
+	SourceMaxConcurrent int      `json:"source_max_concurrent"`
+	DailyBytes          ByteSize `json:"daily_bytes"`
 }
 
 type ReplicationTarget struct {
@@ -251,14 +433,380 @@ type ReplicationTarget struct {
 	Name string `json:"name"`
 }
 
+// DefaultReplicationRule is the rule recorded for the targets taken from
+// replication_targets, and InputReplicationRule the one recorded for the
+// targets listed in the input file.
+const (
+	DefaultReplicationRule = "default"
+	InputReplicationRule   = "input"
+)
+
+// ReplicationRule replicates the AIPs matching all the conditions of Match to
+// Targets. Rules are evaluated in order and the targets of every matching
+// rule are added, unless a matching rule is Exclusive: its targets are then
+// the only ones.
+type ReplicationRule struct {
+	Name      string               `json:"name"`
+	Match     ReplicationRuleMatch `json:"match"`
+	Targets   []string             `json:"targets"`
+	Exclusive bool                 `json:"exclusive"`
+}
+
+// ReplicationRuleMatch lists the conditions on the package attributes
+// returned by the Storage Service. Empty conditions match every package.
+type ReplicationRuleMatch struct {
+	// OriginPipelines and Locations are UUIDs; the package matches when its
+	// origin pipeline or current location is one of them.
+	OriginPipelines []string `json:"origin_pipelines"`
+	Locations       []string `json:"locations"`
+	PackageTypes    []string `json:"package_types"`
+	MinSize         ByteSize `json:"min_size"`
+	MaxSize         ByteSize `json:"max_size"`
+	Encrypted       *bool    `json:"encrypted"`
+}
+
 // WorkflowConfig holds configuration for individual workflows.
 type WorkflowConfig struct {
-	Move WorkflowMoveConfig `json:"move"`
+	Move      WorkflowMoveConfig      `json:"move"`
+	Replicate WorkflowReplicateConfig `json:"replicate"`
+	Pipeline  WorkflowPipelineConfig  `json:"pipeline"`
+	Cleanup   WorkflowCleanupConfig   `json:"cleanup"`
+	Batch     WorkflowBatchConfig     `json:"batch"`
 }
 
 // WorkflowMoveConfig controls behaviour specific to the move workflow.
 type WorkflowMoveConfig struct {
 	CheckFixity bool `json:"check_fixity"`
+
+	// VerifyAfterMove runs a fixity check again once the AIP is stored in the
+	// move target location.
+	VerifyAfterMove bool `json:"verify_after_move"`
+
+	// Reindex updates the search index of Archivematica after the move.
+	Reindex WorkflowReindexConfig `json:"reindex"`
+
+	Activities ActivitiesConfig `json:"activities"`
+}
+
+// DefaultReindexCommand is the Archivematica dashboard management command run
+// to re-index a moved AIP when none is configured.
+var DefaultReindexCommand = []string{"rebuild_aip_index_from_storage_service", "--uuid", "{uuid}"}
+
+// Patterns matched against the output of the re-index command when none are
+// configured: the summary printed by the Archivematica rebuild commands, e.g.
+// "Indexing complete. Indexed 1 AIPs.", and the errors they report.
+const (
+	DefaultReindexSuccessPattern = `(?i)indexed [1-9][0-9]* AIPs?`
+	DefaultReindexFailurePattern = `(?i)CommandError|Traceback|indexed 0 AIPs?`
+)
+
+// WorkflowReindexConfig controls the re-index step of the move workflow.
+type WorkflowReindexConfig struct {
+	Enabled bool `json:"enabled"`
+
+	// Management describes where the command runs, usually the Archivematica
+	// dashboard. Defaults to storage_service.management.
+	Management *StorageServiceManagementConfig `json:"management"`
+
+	// Command is the management command and its arguments, "{uuid}" is
+	// replaced with the UUID of the AIP. Defaults to DefaultReindexCommand.
+	Command []string `json:"command"`
+
+	// SuccessPattern is a regular expression the output of the command must
+	// match, and FailurePattern one that fails the re-index even when the
+	// command exits with zero. They default to DefaultReindexSuccessPattern
+	// and DefaultReindexFailurePattern; "-" disables a pattern.
+	SuccessPattern string `json:"success_pattern"`
+	FailurePattern string `json:"failure_pattern"`
+}
+
+func (c *WorkflowReindexConfig) applyDefaults() error {
+	if len(c.Command) == 0 {
+		c.Command = slices.Clone(DefaultReindexCommand)
+	}
+	c.SuccessPattern = cmp.Or(c.SuccessPattern, DefaultReindexSuccessPattern)
+	c.FailurePattern = cmp.Or(c.FailurePattern, DefaultReindexFailurePattern)
+	for _, pattern := range []string{c.SuccessPattern, c.FailurePattern} {
+		if _, err := reindexPattern(pattern); err != nil {
+			return fmt.Errorf("workflows.move.reindex: %w", err)
+		}
+	}
+	if c.Management != nil {
+		return c.Management.applyDefaults("workflows.move.reindex.management")
+	}
+	return nil
+}
+
+// WorkflowReplicateConfig controls behaviour specific to the replicate
+// workflow.
+type WorkflowReplicateConfig struct {
+	Activities ActivitiesConfig `json:"activities"`
+}
+
+// Steps of the pipeline workflow.
+const (
+	PipelineStepFixity    = "fixity"
+	PipelineStepMove      = "move"
+	PipelineStepReplicate = "replicate"
+	PipelineStepVerify    = "verify"
+	PipelineStepCleanup   = "cleanup"
+)
+
+// pipelineSteps lists every step the pipeline workflow can run.
+var pipelineSteps = []string{
+	PipelineStepFixity,
+	PipelineStepMove,
+	PipelineStepReplicate,
+	PipelineStepVerify,
+	PipelineStepCleanup,
+}
+
+// DefaultPipelineSteps are the steps run by the pipeline workflow when none
+// are configured.
+var DefaultPipelineSteps = []string{
+	PipelineStepFixity,
+	PipelineStepMove,
+	PipelineStepReplicate,
+	PipelineStepVerify,
+}
+
+// WorkflowPipelineConfig controls the pipeline workflow, which runs several
+// steps for each AIP.
+type WorkflowPipelineConfig struct {
+	// Steps run in order for each AIP. Defaults to DefaultPipelineSteps.
+	Steps []string `json:"steps"`
+
+	Activities ActivitiesConfig `json:"activities"`
+}
+
+func (c *WorkflowPipelineConfig) applyDefaults() error {
+	if len(c.Steps) == 0 {
+		c.Steps = slices.Clone(DefaultPipelineSteps)
+	}
+	seen := map[string]bool{}
+	for _, step := range c.Steps {
+		if !slices.Contains(pipelineSteps, step) {
+			return fmt.Errorf("unknown workflows.pipeline.steps entry %q", step)
+		}
+		if seen[step] {
+			return fmt.Errorf("duplicate workflows.pipeline.steps entry %q", step)
+		}
+		seen[step] = true
+	}
+	// Only verified AIPs are cleaned up.
+	cleanup, verify := slices.Index(c.Steps, PipelineStepCleanup), slices.Index(c.Steps, PipelineStepVerify)
+	if cleanup >= 0 && (verify < 0 || cleanup < verify) {
+		return errors.New("workflows.pipeline.steps: cleanup must come after verify")
+	}
+	return nil
+}
+
+// WorkflowCleanupConfig controls the cleanup step, which deletes the copies
+// of an AIP left behind once it has been moved or replicated and verified.
+type WorkflowCleanupConfig struct {
+	// Enabled opts in to the cleanup step. Nothing is deleted otherwise.
+	Enabled bool `json:"enabled"`
+
+	// DryRun records the artefacts that would be deleted without deleting
+	// them.
+	DryRun bool `json:"dry_run"`
+
+	// LeftoverDirs are directories reachable from the workers, e.g. the
+	// filesystem path of the old location or a staging directory, where
+	// copies of an AIP named after its UUID may be left behind.
+	LeftoverDirs []string `json:"leftover_dirs"`
+
+	// PathMappings translate the paths reported by the Storage Service into
+	// the paths of the same directories on the workers, so that the copies
+	// it uses are recognized in the leftover directories.
+	PathMappings []CleanupPathMapping `json:"path_mappings"`
+
+	// DeleteOriginal asks the Storage Service to delete the original package
+	// once every replica has been verified.
+	DeleteOriginal DeleteOriginalConfig `json:"delete_original"`
+
+	Activities ActivitiesConfig `json:"activities"`
+}
+
+// CleanupPathMapping maps a directory of the Storage Service to the path it is
+// mounted at on the workers.
+type CleanupPathMapping struct {
+	StorageService string `json:"storage_service"`
+	Worker         string `json:"worker"`
+}
+
+// workerPath returns the path a Storage Service path has on the workers,
+// using the mapping with the longest matching directory.
+func (c WorkflowCleanupConfig) workerPath(path string) string {
+	if path == "" {
+		return ""
+	}
+	path = filepath.Clean(path)
+	mapped, longest := path, -1
+	for _, m := range c.PathMappings {
+		dir := filepath.Clean(m.StorageService)
+		rel, err := filepath.Rel(dir, path)
+		if err != nil || rel == ".." || strings.HasPrefix(rel, ".."+string(filepath.Separator)) || len(dir) <= longest {
+			continue
+		}
+		mapped, longest = filepath.Join(m.Worker, rel), len(dir)
+	}
+	return mapped
+}
+
+// DeleteOriginalConfig holds the details of the deletion requests filed with
+// the Storage Service, which an administrator approves there.
+type DeleteOriginalConfig struct {
+	Enabled   bool   `json:"enabled"`
+	Reason    string `json:"reason"`
+	UserID    int    `json:"user_id"`
+	UserEmail string `json:"user_email"`
+}
+
+func (c *WorkflowCleanupConfig) validate() error {
+	d := c.DeleteOriginal
+	if d.Enabled && (d.Reason == "" || d.UserID == 0 || d.UserEmail == "") {
+		return errors.New("workflows.cleanup.delete_original requires reason, user_id and user_email")
+	}
+	for _, m := range c.PathMappings {
+		if !filepath.IsAbs(m.StorageService) || !filepath.IsAbs(m.Worker) {
+			return errors.New("workflows.cleanup.path_mappings requires absolute storage_service and worker paths")
+		}
+	}
+	return nil
+}
+
+// ActivitiesConfig maps activity names to their options. The "default" entry
+// applies to every activity of the workflow and the entry named after the
+// activity, e.g. "find-aip", overrides it.
+type ActivitiesConfig map[string]ActivityConfig
+
+// ActivityConfig sets the timeouts and retry policy of an activity. Unset
+// fields keep the built-in values.
+type ActivityConfig struct {
+	StartToCloseTimeout    Duration `json:"start_to_close_timeout"`
+	ScheduleToCloseTimeout Duration `json:"schedule_to_close_timeout"`
+	HeartbeatTimeout       Duration `json:"heartbeat_timeout"`
+
+	InitialInterval        Duration `json:"initial_interval"`
+	BackoffCoefficient     float64  `json:"backoff_coefficient"`
+	MaximumInterval        Duration `json:"maximum_interval"`
+	MaximumAttempts        int32    `json:"maximum_attempts"`
+	NonRetryableErrorTypes []string `json:"non_retryable_error_types"`
+}
+
+// Duration is a time.Duration written as a string in the config file, e.g.
+// "90s" or "2h30m".
+type Duration time.Duration
+
+func (d *Duration) UnmarshalJSON(data []byte) error {
+	var s string
+	if err := json.Unmarshal(data, &s); err != nil {
+		return fmt.Errorf("duration must be a string: %w", err)
+	}
+	v, err := time.ParseDuration(s)
+	if err != nil {
+		return err
+	}
+	*d = Duration(v)
+	return nil
+}
+
+func (d Duration) MarshalJSON() ([]byte, error) {
+	return json.Marshal(time.Duration(d).String())
+}
+
+// ByteSize is a number of bytes written in the config file either as a number
+// or as a string with a unit, e.g. "500GB" or "4TiB".
+type ByteSize int64
+
+var byteSizeUnits = map[string]int64{
+	"":    1,
+	"B":   1,
+	"KB":  1e3,
+	"MB":  1e6,
+	"GB":  1e9,
+	"TB":  1e12,
+	"PB":  1e15,
+	"KIB": 1 << 10,
+	"MIB": 1 << 20,
+	"GIB": 1 << 30,
+	"TIB": 1 << 40,
+	"PIB": 1 << 50,
+}
+
+// ParseByteSize parses sizes such as "1024", "500GB" or "4 TiB".
+func ParseByteSize(s string) (ByteSize, error) {
+	s = strings.TrimSpace(s)
+	i := strings.IndexFunc(s, func(r rune) bool { return (r < '0' || r > '9') && r != '.' })
+	if i < 0 {
+		i = len(s)
+	}
+	n, err := strconv.ParseFloat(s[:i], 64)
+	if err != nil || n < 0 {
+		return 0, fmt.Errorf("invalid size %q", s)
+	}
+	unit, ok := byteSizeUnits[strings.ToUpper(strings.TrimSpace(s[i:]))]
+	if !ok {
+		return 0, fmt.Errorf("invalid size unit in %q", s)
+	}
+	return ByteSize(n * float64(unit)), nil
+}
+
+func (b *ByteSize) UnmarshalJSON(data []byte) error {
+	var n int64
+	if err := json.Unmarshal(data, &n); err == nil {
+		*b = ByteSize(n)
+		return nil
+	}
+	var s string
+	if err := json.Unmarshal(data, &s); err != nil {
+		return fmt.Errorf("size must be a number or a string: %w", err)
+	}
+	v, err := ParseByteSize(s)
+	if err != nil {
+		return err
+	}
+	*b = v
+	return nil
+}
+
+// Order values accepted by WorkflowBatchConfig.Order.
+const (
+	OrderInput         = "input"
+	OrderLargestFirst  = "largest-first"
+	OrderSmallestFirst = "smallest-first"
+	OrderPriority      = "priority"
+)
+
+// WorkflowBatchConfig controls the batch workflow submitted by the move and
+// replicate commands.
+type WorkflowBatchConfig struct {
+	// MaxConcurrent is the maximum number of per-AIP workflows a batch runs at
+	// the same time. Defaults to 1.
+	MaxConcurrent int `json:"max_concurrent"`
+
+	// Order in which the AIPs of a batch are submitted: "input" (the order of
+	// input.txt, the default), "largest-first", "smallest-first" or "priority"
+	// (highest aips.priority first).
+	Order string `json:"order"`
+}
+
+// ScheduleConfig restricts when new moves and replications start. Without
+// windows they start at any time.
+type ScheduleConfig struct {
+	// Timezone the window start times are expressed in, e.g.
+	// "America/Vancouver". Defaults to the local timezone.
+	Timezone string `json:"timezone"`
+
+	Windows []ScheduleWindow `json:"windows"`
+}
+
+// ScheduleWindow is a period that opens at the times given by a standard cron
+// expression and stays open for Duration.
+type ScheduleWindow struct {
+	Start    string   `json:"start"`
+	Duration Duration `json:"duration"`
 }
 
 type DatabaseConfig struct {
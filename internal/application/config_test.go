@@ -4,6 +4,7 @@ import (
 	"os"
 	"path/filepath"
 	"testing"
+	"time"
 
 	"gotest.tools/v3/assert"
 )
@@ -42,6 +43,10 @@ func TestLoadConfig(t *testing.T) {
 	assert.Equal(t, mgmt.Host.PythonPath, "python3")
 	assert.Equal(t, mgmt.Host.ManagePath, "/src/src/archivematica/storage_service/manage.py")
 	assert.Equal(t, mgmt.Host.Environment["DJANGO_SETTINGS_MODULE"], "archivematica.storage_service.storage_service.settings.local")
+	assert.Equal(t, mgmt.Kubectl.Pod, "deployment/archivematica-storage-service")
+	assert.Equal(t, mgmt.SSH.Host, "storage-service.example.com")
+	assert.Equal(t, mgmt.SSH.Port, 22)
+	assert.Equal(t, mgmt.Timeout, Duration(0))
 
 	locs := cfg.StorageService.Locations
 	assert.Equal(t, locs.SourceLocationID, "source-location-uuid")
@@ -49,6 +54,20 @@ func TestLoadConfig(t *testing.T) {
 	assert.DeepEqual(t, locs.ReplicationTargets, []ReplicationTarget{
 		{ID: "replica-location-1", Name: "Replica Location 1"},
 	})
+	assert.DeepEqual(t, locs.Limits, []LocationLimit{
+		{LocationID: "replica-location-1", MaxConcurrent: 2, SourceMaxConcurrent: 4, DailyBytes: 4 << 40},
+	})
+	assert.Equal(t, len(locs.Mappings), 0)
+	assert.DeepEqual(t, locs.ReplicationRules, []ReplicationRule{
+		{
+			Name: "large-aips-offsite",
+			Match: ReplicationRuleMatch{
+				OriginPipelines: []string{"pipeline-uuid"},
+				MinSize:         100_000_000_000,
+			},
+			Targets: []string{"replica-location-2"},
+		},
+	})
 
 	assert.Equal(t, cfg.Temporal.Address, "127.0.0.1:7233")
 	assert.Equal(t, cfg.Temporal.TaskQueue, "default-task-queue")
@@ -57,4 +76,87 @@ func TestLoadConfig(t *testing.T) {
 	assert.Equal(t, cfg.Database.SQLite.Path, DefaultSQLitePath())
 
 	assert.Assert(t, !cfg.Workflows.Move.CheckFixity)
+	assert.Assert(t, !cfg.Workflows.Move.VerifyAfterMove)
+	assert.DeepEqual(t, cfg.Workflows.Move.Reindex, WorkflowReindexConfig{
+		Management: &StorageServiceManagementConfig{
+			Mode: "docker",
+			Docker: StorageServiceDockerConfig{
+				Container:  "am-archivematica-dashboard-1",
+				ManagePath: "/src/src/archivematica/dashboard/manage.py",
+			},
+		},
+		Command:        []string{"rebuild_aip_index_from_storage_service", "--uuid", "{uuid}"},
+		SuccessPattern: DefaultReindexSuccessPattern,
+		FailurePattern: DefaultReindexFailurePattern,
+	})
+	assert.DeepEqual(t, cfg.Workflows.Move.Activities, ActivitiesConfig{
+		"default": {
+			StartToCloseTimeout: Duration(24 * time.Hour),
+			InitialInterval:     Duration(10 * time.Second),
+			BackoffCoefficient:  2,
+			MaximumInterval:     Duration(10 * time.Minute),
+			MaximumAttempts:     5,
+		},
+		"fixity-activity": {
+			HeartbeatTimeout: Duration(time.Minute),
+		},
+	})
+	assert.DeepEqual(t, cfg.Workflows.Replicate.Activities, ActivitiesConfig{
+		"Replicate-aip": {
+			StartToCloseTimeout:    Duration(48 * time.Hour),
+			HeartbeatTimeout:       Duration(time.Minute),
+			MaximumAttempts:        1,
+			NonRetryableErrorTypes: []string{"StorageServiceUnavailable"},
+		},
+	})
+	assert.DeepEqual(t, cfg.Workflows.Pipeline.Steps, []string{"fixity", "move", "replicate", "verify"})
+	assert.DeepEqual(t, cfg.Workflows.Cleanup, WorkflowCleanupConfig{
+		DryRun:       true,
+		LeftoverDirs: []string{},
+		PathMappings: []CleanupPathMapping{},
+		DeleteOriginal: DeleteOriginalConfig{
+			Reason:    "Replicated by migrate",
+			UserID:    1,
+			UserEmail: "admin@example.com",
+		},
+	})
+	assert.Equal(t, cfg.Workflows.Batch.MaxConcurrent, 1)
+	assert.Equal(t, cfg.Workflows.Batch.Order, OrderInput)
+
+	assert.DeepEqual(t, cfg.Schedule, ScheduleConfig{
+		Timezone: "America/Vancouver",
+		Windows: []ScheduleWindow{
+			{Start: "0 20 * * 1-5", Duration: Duration(10 * time.Hour)},
+			{Start: "0 0 * * 6", Duration: Duration(48 * time.Hour)},
+		},
+	})
+}
+
+func TestParseByteSize(t *testing.T) {
+	t.Parallel()
+
+	for _, tc := range []struct {
+		in   string
+		want ByteSize
+		err  string
+	}{
+		{in: "1024", want: 1024},
+		{in: "500GB", want: 500e9},
+		{in: "4TiB", want: 4 << 40},
+		{in: "1.5 kib", want: 1536},
+		{in: "10XB", err: "invalid size unit"},
+		{in: "TiB", err: "invalid size"},
+	} {
+		t.Run(tc.in, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := ParseByteSize(tc.in)
+			if tc.err != "" {
+				assert.ErrorContains(t, err, tc.err)
+				return
+			}
+			assert.NilError(t, err)
+			assert.Equal(t, got, tc.want)
+		})
+	}
 }
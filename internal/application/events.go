@@ -20,11 +20,15 @@ func (a Action) String() string {
 }
 
 var (
-	ActionFind      = Action{"find"}
-	ActionFixity    = Action{"fixity"}
-	ActionMove      = Action{"move"}
-	ActionReplicate = Action{"Replicate"}
-	ActionIndex     = Action{"index"}
+	ActionFind              = Action{"find"}
+	ActionFixity            = Action{"fixity"}
+	ActionDestinationFixity = Action{"destination-fixity"}
+	ActionMove              = Action{"move"}
+	ActionReplicate         = Action{"Replicate"}
+	ActionIndex             = Action{"index"}
+	ActionVerify            = Action{"verify"}
+	ActionVerifyReplicas    = Action{"verify-replicas"}
+	ActionCleanup           = Action{"cleanup"}
 )
 
 type Event struct {
@@ -61,6 +65,7 @@ func StartEvent(a Action) Event {
 // EndEvent marks the AIP with the provided status and stores the event.
 func EndEvent(ctx context.Context, s AIPStatus, a *App, e Event, aip *models.Aip) error {
 	e.End = time.Now()
+	e.Success = true
 	if err := a.UpdateAIPStatus(ctx, aip.ID, s); err != nil {
 		return err
 	}
@@ -116,5 +121,7 @@ func EventToSetter(e Event) (*models.EventSetter, error) {
 		TotalDuration:            omitnull.From(e.Duration().String()),
 		TotalDurationNanoseconds: omitnull.From(e.Duration().Nanoseconds()),
 		Details:                  omitnull.From(formatDetails),
+		Success:                  omit.From(e.Success),
+		EndedAt:                  omitnull.From(e.End.Unix()),
 	}, nil
 }
@@ -5,6 +5,8 @@ import (
 	"errors"
 	"fmt"
 
+	"go.temporal.io/sdk/temporal"
+
 	"github.com/artefactual-labs/migrate/internal/database/gen/models"
 	"github.com/artefactual-labs/migrate/internal/storage_service"
 )
@@ -29,7 +31,10 @@ func (a *App) FixityA(ctx context.Context, params FixityActivityParams) (*Fixity
 		return &FixityActivityResult{Status: aip.Status}, nil
 	}
 
-	if err := checkFixity(ctx, a, a.StorageClient, aip); err != nil {
+	stopHeartbeat := heartbeat(ctx)
+	err = checkFixity(ctx, a, a.StorageClient, aip)
+	stopHeartbeat()
+	if err != nil {
 		return nil, err
 	}
 
@@ -90,3 +95,60 @@ func checkFixity(ctx context.Context, a *App, storageClient *storage_service.API
 
 	return nil
 }
+
+// DestinationFixityFailedErrorType is the type of the error returned when the
+// copy of the AIP in the move target location fails the fixity check.
+const DestinationFixityFailedErrorType = "DestinationFixityFailed"
+
+const DestinationFixityActivityName = "destination-fixity-activity"
+
+// DestinationFixityPassed is the status reported by DestinationFixityA when
+// the copy of the AIP in the move target location passes the fixity check.
+const DestinationFixityPassed = "passed"
+
+// DestinationFixityA checks the fixity of the AIP again once it has been moved
+// to the move target location and reports the outcome of the check. A failed
+// check leaves the AIP with the destination-fixity-failed status.
+func (a *App) DestinationFixityA(ctx context.Context, params FixityActivityParams) (*FixityActivityResult, error) {
+	aip, err := a.GetAIPByID(ctx, params.UUID)
+	if err != nil {
+		return nil, err
+	}
+
+	e := StartEvent(ActionDestinationFixity)
+	e.AddDetail(fmt.Sprintf("Running fixity in the move target location for: %s", aip.UUID))
+
+	stopHeartbeat := heartbeat(ctx)
+	res, err := a.StorageClient.Packages.CheckFixity(ctx, aip.UUID)
+	stopHeartbeat()
+	if err != nil {
+		if eventErr := EndEventErrNoFailure(ctx, a, e, aip, err.Error()); eventErr != nil {
+			return nil, eventErr
+		}
+		return nil, fmt.Errorf("storage service fixity call failed: %w", err)
+	}
+
+	if res.Success {
+		if err := EndEventNoChange(ctx, a, e, aip); err != nil {
+			return nil, err
+		}
+		return &FixityActivityResult{Status: DestinationFixityPassed}, nil
+	}
+
+	a.AddAIPError(ctx, aip, res.Message)
+	for _, f := range res.Failures.Files.Changed {
+		e.AddDetail("file changed: " + f)
+	}
+	for _, f := range res.Failures.Files.Untracked {
+		e.AddDetail("file untracked: " + f)
+	}
+	for _, f := range res.Failures.Files.Missing {
+		e.AddDetail("file missing: " + f)
+	}
+	if err := EndEvent(ctx, AIPStatusDestinationFixityFailed, a, e, aip); err != nil {
+		return nil, err
+	}
+
+	msg := "fixity failed in the move target location: " + res.Message
+	return nil, temporal.NewNonRetryableApplicationError(msg, DestinationFixityFailedErrorType, nil)
+}
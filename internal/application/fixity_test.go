@@ -0,0 +1,68 @@
+package application
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"testing"
+
+	"github.com/aarondl/opt/omit"
+	"go.temporal.io/sdk/activity"
+	"go.temporal.io/sdk/temporal"
+	"go.temporal.io/sdk/testsuite"
+	"gotest.tools/v3/assert"
+
+	"github.com/artefactual-labs/migrate/internal/database/gen/models"
+	"github.com/artefactual-labs/migrate/internal/storage_service"
+)
+
+func TestDestinationFixityA(t *testing.T) {
+	t.Parallel()
+
+	const aipUUID = "7b1e4c2a-3d5f-4a6b-9c8d-0e1f2a3b4c5d"
+
+	// setup returns an activity environment running DestinationFixityA
+	// against a Storage Service that answers the fixity check with res.
+	setup := func(t *testing.T, res storage_service.FixityResponse) (*App, *testsuite.TestActivityEnvironment) {
+		app := newTestApp(t)
+		_, err := models.Aips.Insert(&models.AipSetter{
+			UUID:   omit.From(aipUUID),
+			Status: omit.From(string(AIPStatusMoved)),
+		}).Exec(t.Context(), app.DB)
+		assert.NilError(t, err)
+
+		app.StorageClient = newTestStorageService(t, func(w http.ResponseWriter, r *http.Request) {
+			_ = json.NewEncoder(w).Encode(res)
+		})
+
+		var suite testsuite.WorkflowTestSuite
+		env := suite.NewTestActivityEnvironment()
+		env.RegisterActivityWithOptions(app.DestinationFixityA, activity.RegisterOptions{Name: DestinationFixityActivityName})
+		return app, env
+	}
+
+	t.Run("Reports a passed check", func(t *testing.T) {
+		t.Parallel()
+
+		app, env := setup(t, storage_service.FixityResponse{Success: true})
+		val, err := env.ExecuteActivity(DestinationFixityActivityName, FixityActivityParams{UUID: aipUUID})
+		assert.NilError(t, err)
+
+		var res FixityActivityResult
+		assert.NilError(t, val.Get(&res))
+		assert.Equal(t, res.Status, DestinationFixityPassed)
+		assert.Equal(t, getTestAIP(t, app, aipUUID).Status, string(AIPStatusMoved))
+	})
+
+	t.Run("Fails the AIP when the check fails", func(t *testing.T) {
+		t.Parallel()
+
+		app, env := setup(t, storage_service.FixityResponse{Message: "Bag is invalid"})
+		_, err := env.ExecuteActivity(DestinationFixityActivityName, FixityActivityParams{UUID: aipUUID})
+		var appErr *temporal.ApplicationError
+		assert.Assert(t, errors.As(err, &appErr))
+		assert.Equal(t, appErr.Type(), DestinationFixityFailedErrorType)
+		assert.Assert(t, appErr.NonRetryable())
+		assert.Equal(t, getTestAIP(t, app, aipUUID).Status, string(AIPStatusDestinationFixityFailed))
+	})
+}
@@ -0,0 +1,373 @@
+package application
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/csv"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"path/filepath"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/aarondl/opt/omit"
+	"github.com/google/uuid"
+	"github.com/stephenafamo/bob/dialect/sqlite/im"
+
+	"github.com/artefactual-labs/migrate/internal/database/gen/models"
+	"github.com/artefactual-labs/migrate/internal/storage_service"
+)
+
+// Input columns. Only uuid is required.
+const (
+	InputColumnUUID               = "uuid"
+	InputColumnMoveTarget         = "move_target"
+	InputColumnReplicationTargets = "replication_targets"
+	InputColumnPriority           = "priority"
+	InputColumnTags               = "tags"
+)
+
+var inputColumns = []string{
+	InputColumnUUID,
+	InputColumnMoveTarget,
+	InputColumnReplicationTargets,
+	InputColumnPriority,
+	InputColumnTags,
+}
+
+// Input is the list of AIPs read from an input file.
+type Input struct {
+	// Columns lists the columns of a CSV or TSV input, nil for a list of
+	// UUIDs.
+	Columns []string
+	Rows    []InputRow
+	// Errors lists the rows that were skipped because they are malformed.
+	Errors []InputError
+	// Checksum is the SHA-256 checksum of the input, hex encoded.
+	Checksum string
+}
+
+// InputRow is an AIP listed in the input, with the settings it overrides.
+type InputRow struct {
+	Line               int
+	UUID               uuid.UUID
+	MoveTarget         string
+	ReplicationTargets []string
+	Priority           *int64
+	Tags               []string
+}
+
+// InputError reports a malformed input row.
+type InputError struct {
+	Line int
+	Err  error
+}
+
+func (e InputError) Error() string {
+	return fmt.Sprintf("line %d: %v", e.Line, e.Err)
+}
+
+func (e InputError) Unwrap() error {
+	return e.Err
+}
+
+// UUIDs returns the UUIDs of the AIPs in the input, in order.
+func (in *Input) UUIDs() []uuid.UUID {
+	uuids := make([]uuid.UUID, len(in.Rows))
+	for i, row := range in.Rows {
+		uuids[i] = row.UUID
+	}
+	return uuids
+}
+
+// HasColumn reports whether the input has the column.
+func (in *Input) HasColumn(name string) bool {
+	return slices.Contains(in.Columns, name)
+}
+
+// ReadInput reads the AIPs listed in r: one UUID per line, or a CSV or TSV
+// file whose header row names its columns. Inputs named with a .csv or .tsv
+// extension, and inputs whose first line is a header starting with the uuid
+// column, are read as CSV or TSV. Malformed rows are skipped and reported in
+// Input.Errors.
+func ReadInput(name string, r io.Reader) (*Input, error) {
+	data, err := io.ReadAll(r)
+	if err != nil {
+		return nil, err
+	}
+	in, err := parseInput(data, inputDelimiter(name, data))
+	if err != nil {
+		return nil, err
+	}
+	sum := sha256.Sum256(data)
+	in.Checksum = hex.EncodeToString(sum[:])
+	return in, nil
+}
+
+// inputDelimiter returns the field delimiter of the input, or zero for a list
+// of UUIDs.
+func inputDelimiter(path string, data []byte) rune {
+	switch strings.ToLower(filepath.Ext(path)) {
+	case ".csv":
+		return ','
+	case ".tsv":
+		return '\t'
+	}
+	first, _, _ := bytes.Cut(bytes.TrimLeft(data, "\r\n"), []byte("\n"))
+	rest, ok := bytes.CutPrefix(bytes.ToLower(bytes.TrimSpace(first)), []byte(InputColumnUUID))
+	if !ok {
+		return 0
+	}
+	rest = bytes.TrimLeft(rest, " ")
+	switch {
+	case len(rest) == 0:
+		// A single uuid column.
+		return ','
+	case rest[0] == '\t':
+		return '\t'
+	case rest[0] == ',':
+		return ','
+	}
+	return 0
+}
+
+func parseInput(data []byte, delimiter rune) (*Input, error) {
+	in := &Input{}
+	seen := map[uuid.UUID]int{}
+	add := func(row InputRow) {
+		if line, ok := seen[row.UUID]; ok {
+			in.Errors = append(in.Errors, InputError{Line: row.Line, Err: fmt.Errorf("duplicate UUID %s, first listed on line %d", row.UUID, line)})
+			return
+		}
+		seen[row.UUID] = row.Line
+		in.Rows = append(in.Rows, row)
+	}
+
+	if delimiter == 0 {
+		scanner := bufio.NewScanner(bytes.NewReader(data))
+		for line := 1; scanner.Scan(); line++ {
+			text := strings.TrimSpace(scanner.Text())
+			if text == "" {
+				continue
+			}
+			id, err := uuid.Parse(text)
+			if err != nil {
+				in.Errors = append(in.Errors, InputError{Line: line, Err: fmt.Errorf("invalid UUID %q", text)})
+				continue
+			}
+			add(InputRow{Line: line, UUID: id})
+		}
+		return in, scanner.Err()
+	}
+
+	r := csv.NewReader(bytes.NewReader(data))
+	r.Comma = delimiter
+	r.TrimLeadingSpace = true
+	header, err := r.Read()
+	if err != nil {
+		return nil, fmt.Errorf("read input header: %w", err)
+	}
+	for _, name := range header {
+		name = strings.ToLower(strings.TrimSpace(name))
+		if !slices.Contains(inputColumns, name) {
+			return nil, fmt.Errorf("unknown input column %q, expected %s", name, strings.Join(inputColumns, ", "))
+		}
+		if slices.Contains(in.Columns, name) {
+			return nil, fmt.Errorf("duplicate input column %q", name)
+		}
+		in.Columns = append(in.Columns, name)
+	}
+	if !in.HasColumn(InputColumnUUID) {
+		return nil, fmt.Errorf("missing input column %q", InputColumnUUID)
+	}
+
+	for {
+		record, err := r.Read()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			var perr *csv.ParseError
+			if !errors.As(err, &perr) {
+				return nil, fmt.Errorf("read input: %w", err)
+			}
+			in.Errors = append(in.Errors, InputError{Line: perr.StartLine, Err: perr.Err})
+			continue
+		}
+		// The position of the record is only known after a successful read.
+		line, _ := r.FieldPos(0)
+		if len(record) == 1 && strings.TrimSpace(record[0]) == "" {
+			continue
+		}
+		row, err := parseInputRow(in.Columns, record)
+		if err != nil {
+			in.Errors = append(in.Errors, InputError{Line: line, Err: err})
+			continue
+		}
+		row.Line = line
+		add(row)
+	}
+	return in, nil
+}
+
+func parseInputRow(columns, record []string) (InputRow, error) {
+	var row InputRow
+	for i, name := range columns {
+		value := strings.TrimSpace(record[i])
+		switch name {
+		case InputColumnUUID:
+			id, err := uuid.Parse(value)
+			if err != nil {
+				return row, fmt.Errorf("invalid UUID %q", value)
+			}
+			row.UUID = id
+		case InputColumnMoveTarget:
+			if value != "" {
+				if err := uuid.Validate(value); err != nil {
+					return row, fmt.Errorf("invalid move target %q", value)
+				}
+			}
+			row.MoveTarget = value
+		case InputColumnReplicationTargets:
+			for _, target := range splitInputList(value) {
+				if err := uuid.Validate(target); err != nil {
+					return row, fmt.Errorf("invalid replication target %q", target)
+				}
+				row.ReplicationTargets = append(row.ReplicationTargets, target)
+			}
+		case InputColumnPriority:
+			if value == "" {
+				continue
+			}
+			priority, err := strconv.ParseInt(value, 10, 64)
+			if err != nil {
+				return row, fmt.Errorf("invalid priority %q", value)
+			}
+			row.Priority = &priority
+		case InputColumnTags:
+			row.Tags = splitInputList(value)
+		}
+	}
+	return row, nil
+}
+
+// splitInputList splits a list of values separated by semicolons or commas.
+func splitInputList(s string) []string {
+	var values []string
+	for _, v := range strings.FieldsFunc(s, func(r rune) bool { return r == ';' || r == ',' }) {
+		if v = strings.TrimSpace(v); v != "" {
+			values = append(values, v)
+		}
+	}
+	return values
+}
+
+// LoadInput reads the input and records the overrides of the AIPs it lists in
+// the database, creating the AIPs that are not there yet. Malformed rows are
+// logged and skipped.
+func (a *App) LoadInput(ctx context.Context, name string, r io.Reader) (*Input, error) {
+	in, err := ReadInput(name, r)
+	if err != nil {
+		return nil, err
+	}
+	for _, e := range in.Errors {
+		a.logger.Warn("Skipping malformed input row", "input", name, "line", e.Line, "error", e.Err)
+	}
+	if len(in.Columns) <= 1 {
+		return in, nil
+	}
+	for _, row := range in.Rows {
+		if err := a.storeInputRow(ctx, in, row); err != nil {
+			return nil, fmt.Errorf("line %d: %w", row.Line, err)
+		}
+	}
+	return in, nil
+}
+
+// storeInputRow records the overrides of the row. The columns of the input
+// are all written, so an empty cell clears a previous override.
+func (a *App) storeInputRow(ctx context.Context, in *Input, row InputRow) error {
+	_, err := models.Aips.Insert(
+		&models.AipSetter{
+			UUID:   omit.From(row.UUID.String()),
+			Status: omit.From(string(AIPStatusNew)),
+		},
+		im.OnConflict("uuid").DoNothing(),
+	).Exec(ctx, a.DB)
+	if err != nil {
+		return fmt.Errorf("insert AIP: %w", err)
+	}
+
+	setter := &models.AipSetter{}
+	if in.HasColumn(InputColumnMoveTarget) {
+		setter.MoveTargetOverride = omit.From(row.MoveTarget)
+	}
+	if in.HasColumn(InputColumnReplicationTargets) {
+		v, err := encodeInputList(row.ReplicationTargets)
+		if err != nil {
+			return err
+		}
+		setter.ReplicationTargetsOverride = omit.From(v)
+	}
+	if in.HasColumn(InputColumnTags) {
+		v, err := encodeInputList(row.Tags)
+		if err != nil {
+			return err
+		}
+		setter.Tags = omit.From(v)
+	}
+	if row.Priority != nil {
+		setter.Priority = omit.From(*row.Priority)
+	}
+	if _, err := models.Aips.Update(
+		setter.UpdateMod(),
+		models.UpdateWhere.Aips.UUID.EQ(row.UUID.String()),
+	).Exec(ctx, a.DB); err != nil {
+		return fmt.Errorf("update AIP: %w", err)
+	}
+	return nil
+}
+
+// encodeInputList encodes a list of the input as stored in the database.
+func encodeInputList(values []string) (string, error) {
+	if len(values) == 0 {
+		return "", nil
+	}
+	b, err := json.Marshal(values)
+	return string(b), err
+}
+
+// decodeInputList decodes a list of the input stored in the database.
+func decodeInputList(s string) ([]string, error) {
+	if s == "" {
+		return nil, nil
+	}
+	var values []string
+	if err := json.Unmarshal([]byte(s), &values); err != nil {
+		return nil, fmt.Errorf("decode input list: %w", err)
+	}
+	return values, nil
+}
+
+// aipReplicationTargets returns the replication targets of the AIP: those of
+// the input file, or the ones chosen by the configured locations.
+func aipReplicationTargets(locations StorageServiceLocationConfig, aip *models.Aip, pkg *storage_service.Package) ([]ruleTarget, error) {
+	override, err := decodeInputList(aip.ReplicationTargetsOverride)
+	if err != nil {
+		return nil, err
+	}
+	if len(override) == 0 {
+		return locations.forAIP(aip).replicationTargets(pkg), nil
+	}
+	targets := make([]ruleTarget, len(override))
+	for i, id := range override {
+		targets[i] = ruleTarget{LocationID: id, Rule: InputReplicationRule}
+	}
+	return targets, nil
+}
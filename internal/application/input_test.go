@@ -0,0 +1,129 @@
+package application
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/google/uuid"
+	"gotest.tools/v3/assert"
+)
+
+func TestReadInput(t *testing.T) {
+	t.Parallel()
+
+	const (
+		aip1   = "2faa61dc-ed33-49f4-8b36-954f203bab4a"
+		aip2   = "d4e6ba58-5bbc-4d2f-a11c-52f01f5a0e6c"
+		target = "71cb2196-5629-4225-aaf7-d8431b0895c4"
+		other  = "c4c0e3e5-7b8a-4d8a-9a43-0c2b38c1ee0f"
+	)
+	priority := int64(5)
+
+	for _, tc := range []struct {
+		name       string
+		file       string
+		data       string
+		wantRows   []InputRow
+		wantErrors []string
+		wantErr    string
+	}{
+		{
+			name: "UUIDs",
+			file: "input.txt",
+			data: aip1 + "\n\nnot-a-uuid\n" + aip2 + "\n" + aip1 + "\n",
+			wantRows: []InputRow{
+				{Line: 1, UUID: uuid.MustParse(aip1)},
+				{Line: 4, UUID: uuid.MustParse(aip2)},
+			},
+			wantErrors: []string{
+				`line 3: invalid UUID "not-a-uuid"`,
+				"line 5: duplicate UUID " + aip1 + ", first listed on line 1",
+			},
+		},
+		{
+			name: "CSV",
+			file: "input.csv",
+			data: "uuid,move_target,replication_targets,priority,tags\n" +
+				aip1 + "," + target + ",\"" + target + "," + other + "\",5,a;b\n" +
+				aip2 + ",,,,\n",
+			wantRows: []InputRow{
+				{
+					Line:               2,
+					UUID:               uuid.MustParse(aip1),
+					MoveTarget:         target,
+					ReplicationTargets: []string{target, other},
+					Priority:           &priority,
+					Tags:               []string{"a", "b"},
+				},
+				{Line: 3, UUID: uuid.MustParse(aip2)},
+			},
+		},
+		{
+			name: "TSV detected from the header",
+			file: "input.txt",
+			data: "UUID\tpriority\n" + aip1 + "\t5\n",
+			wantRows: []InputRow{
+				{Line: 2, UUID: uuid.MustParse(aip1), Priority: &priority},
+			},
+		},
+		{
+			name: "Malformed rows",
+			file: "input.csv",
+			data: "uuid,move_target,priority\n" +
+				aip1 + ",elsewhere,1\n" +
+				aip2 + ",,high\n" +
+				aip2 + ",,1,extra\n" +
+				aip1 + ",," + "\n",
+			wantRows: []InputRow{
+				{Line: 5, UUID: uuid.MustParse(aip1)},
+			},
+			wantErrors: []string{
+				`line 2: invalid move target "elsewhere"`,
+				`line 3: invalid priority "high"`,
+				"line 4: wrong number of fields",
+			},
+		},
+		{
+			name: "Malformed quotes",
+			file: "input.csv",
+			data: "uuid,priority\n" +
+				"\"x\"y,2\n" +
+				aip1 + ",5\n",
+			wantRows: []InputRow{
+				{Line: 3, UUID: uuid.MustParse(aip1), Priority: &priority},
+			},
+			wantErrors: []string{
+				`line 2: extraneous or missing " in quoted-field`,
+			},
+		},
+		{
+			name:    "Unknown column",
+			file:    "input.csv",
+			data:    "uuid,location\n" + aip1 + ",x\n",
+			wantErr: `unknown input column "location", expected uuid, move_target, replication_targets, priority, tags`,
+		},
+		{
+			name:    "Missing uuid column",
+			file:    "input.csv",
+			data:    "priority\n1\n",
+			wantErr: `missing input column "uuid"`,
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			in, err := ReadInput(tc.file, strings.NewReader(tc.data))
+			if tc.wantErr != "" {
+				assert.Error(t, err, tc.wantErr)
+				return
+			}
+			assert.NilError(t, err)
+			assert.DeepEqual(t, in.Rows, tc.wantRows)
+			var errs []string
+			for _, e := range in.Errors {
+				errs = append(errs, e.Error())
+			}
+			assert.DeepEqual(t, errs, tc.wantErrors)
+		})
+	}
+}
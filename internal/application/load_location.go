@@ -0,0 +1,47 @@
+package application
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/google/uuid"
+
+	"github.com/artefactual-labs/migrate/internal/storage_service"
+)
+
+// LoadLocation adds the AIPs stored in the location to the database, with the
+// size and status reported by the Storage Service, and returns their UUIDs in
+// the order they were listed.
+func (a *App) LoadLocation(ctx context.Context, locationID string) ([]uuid.UUID, error) {
+	pkgs, err := a.StorageClient.Packages.List(ctx, storage_service.PackageListOptions{
+		LocationID:  locationID,
+		PackageType: "AIP",
+	})
+	if err != nil {
+		return nil, fmt.Errorf("list packages in location %s: %w", locationID, err)
+	}
+	a.logger.Info("Listed AIPs", "location", locationID, "count", len(pkgs))
+
+	uuids := make([]uuid.UUID, 0, len(pkgs))
+	for _, pkg := range pkgs {
+		id, err := uuid.Parse(pkg.UUID)
+		if err != nil {
+			return nil, fmt.Errorf("package %q: invalid UUID: %w", pkg.UUID, err)
+		}
+		if _, err := a.InitAIPInDatabase(ctx, id, nil); err != nil {
+			return nil, fmt.Errorf("init AIP in database: %w", err)
+		}
+		aip, err := a.GetAIPByID(ctx, pkg.UUID)
+		if err != nil {
+			return nil, err
+		}
+		// Like FindA, only AIPs never looked up are updated.
+		if aip.Status == string(AIPStatusNew) {
+			if err := recordPackage(ctx, a.logger, a, StartEvent(ActionFind), aip, &pkg); err != nil {
+				return nil, fmt.Errorf("record AIP %s: %w", pkg.UUID, err)
+			}
+		}
+		uuids = append(uuids, id)
+	}
+	return uuids, nil
+}
@@ -0,0 +1,403 @@
+package application
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"slices"
+	"time"
+
+	"github.com/aarondl/opt/omit"
+	"github.com/stephenafamo/bob"
+	"go.temporal.io/api/enums/v1"
+	"go.temporal.io/api/serviceerror"
+	"go.temporal.io/sdk/activity"
+	"go.temporal.io/sdk/temporal"
+	"go.temporal.io/sdk/workflow"
+
+	"github.com/artefactual-labs/migrate/internal/database/gen/models"
+)
+
+// Waiting for a location lease starts at locationLeaseMinWait and doubles on
+// every attempt up to locationLeaseMaxWait.
+const (
+	locationLeaseMinWait = 10 * time.Second
+	locationLeaseMaxWait = 5 * time.Minute
+)
+
+// Roles of a lease: whether the holder reads from the location or writes to
+// it.
+const (
+	locationLeaseSource = "source"
+	locationLeaseTarget = "target"
+)
+
+// limitedLocations returns the given location IDs that have a concurrency
+// limit or a daily transfer budget configured, without duplicates.
+func (c StorageServiceLocationConfig) limitedLocations(ids ...string) []string {
+	var limited []string
+	for _, id := range ids {
+		l := c.locationLimit(id)
+		if (l.MaxConcurrent <= 0 && l.SourceMaxConcurrent <= 0 && l.DailyBytes <= 0) || slices.Contains(limited, id) {
+			continue
+		}
+		limited = append(limited, id)
+	}
+	return limited
+}
+
+// leaseLimits returns the limits of the given locations.
+func (c StorageServiceLocationConfig) leaseLimits(ids []string) []LocationLimit {
+	limits := make([]LocationLimit, 0, len(ids))
+	for _, id := range ids {
+		limits = append(limits, c.locationLimit(id))
+	}
+	return limits
+}
+
+func (c StorageServiceLocationConfig) locationLimit(id string) LocationLimit {
+	for _, l := range c.Limits {
+		if l.LocationID == id {
+			return l
+		}
+	}
+	return LocationLimit{}
+}
+
+// locationLeases are the leases held by a workflow on limited locations.
+type locationLeases struct {
+	ctx         workflow.Context
+	params      LocationLeaseParams
+	transferred bool
+}
+
+// complete records that the AIP was transferred, so its size counts against
+// the daily budget of the locations when the leases are released.
+func (l *locationLeases) complete() {
+	l.transferred = true
+}
+
+// release gives up the leases. It is safe to call when the workflow has been
+// cancelled.
+func (l *locationLeases) release() {
+	if len(l.params.LocationIDs) == 0 {
+		return
+	}
+	params := l.params
+	params.Transferred = l.transferred
+	releaseCtx, _ := workflow.NewDisconnectedContext(l.ctx)
+	err := workflow.ExecuteActivity(releaseCtx, ReleaseLocationLeasesName, params).Get(releaseCtx, nil)
+	if err != nil {
+		workflow.GetLogger(l.ctx).Error("Failed to release location leases.", "locations", params.LocationIDs, "error", err)
+	}
+}
+
+// acquireLocationLeases blocks the workflow until it holds a lease on the
+// source and target locations for transferring the given AIP, when they are
+// limited. When the daily transfer budget of a location is used up it waits
+// until the next day, in the timezone of the schedule.
+func acquireLocationLeases(ctx workflow.Context, locations StorageServiceLocationConfig, schedule ScheduleConfig, aipUUID, source, target string) (*locationLeases, error) {
+	leaseCtx := workflow.WithActivityOptions(ctx, workflow.ActivityOptions{
+		StartToCloseTimeout: time.Minute,
+		RetryPolicy: &temporal.RetryPolicy{
+			MaximumInterval: time.Minute,
+		},
+	})
+	leases := &locationLeases{ctx: leaseCtx}
+	if v := workflow.GetVersion(ctx, locationLeasesChangeID, workflow.DefaultVersion, 1); v == workflow.DefaultVersion {
+		return leases, nil
+	}
+	limited := locations.limitedLocations(source, target)
+	if len(limited) == 0 {
+		return leases, nil
+	}
+	leases.params = LocationLeaseParams{
+		Holder:      workflow.GetInfo(ctx).WorkflowExecution.ID,
+		AIPUUID:     aipUUID,
+		LocationIDs: limited,
+		Limits:      locations.leaseLimits(limited),
+		Timezone:    schedule.Timezone,
+	}
+	// A location that is both the source and the target is leased as a
+	// target.
+	if source != target && slices.Contains(limited, source) {
+		leases.params.Sources = []string{source}
+	}
+
+	wait := locationLeaseMinWait
+	for {
+		var res AcquireLocationLeasesResult
+		if err := workflow.ExecuteActivity(leaseCtx, AcquireLocationLeasesName, leases.params).Get(ctx, &res); err != nil {
+			return nil, err
+		}
+		if res.Acquired {
+			break
+		}
+		if !res.WaitUntil.IsZero() {
+			workflow.GetLogger(ctx).Info("Daily transfer budget used up, waiting until the next day.", "locations", limited, "until", res.WaitUntil)
+			if err := workflow.Sleep(ctx, res.WaitUntil.Sub(workflow.Now(ctx))); err != nil {
+				return nil, err
+			}
+			wait = locationLeaseMinWait
+			continue
+		}
+		workflow.GetLogger(ctx).Info("Location limit reached, waiting for a lease.", "locations", limited, "wait", wait)
+		if err := workflow.Sleep(ctx, wait); err != nil {
+			return nil, err
+		}
+		wait = min(wait*2, locationLeaseMaxWait)
+	}
+
+	return leases, nil
+}
+
+type LocationLeaseParams struct {
+	// Holder is the ID of the workflow holding the lease.
+	Holder      string
+	AIPUUID     string
+	LocationIDs []string
+
+	// Sources are the locations in LocationIDs the AIP is read from. The
+	// others are written to.
+	Sources []string
+
+	// Limits and Timezone are taken from the settings of the workflow. Leases
+	// requested by runs started before they were passed use the
+	// configuration of the worker.
+	Limits   []LocationLimit
+	Timezone string
+
+	// Transferred is set on release when the AIP was transferred, to record
+	// its size against the daily budget of the locations.
+	Transferred bool
+}
+
+type AcquireLocationLeasesResult struct {
+	Acquired bool
+
+	// WaitUntil is set when a daily transfer budget is used up. It is the
+	// start of the next day, when the budget is renewed.
+	WaitUntil time.Time
+}
+
+// limits returns the limits of the leased locations and the timezone of the
+// daily budgets.
+func (p LocationLeaseParams) limits(cfg *Config) (StorageServiceLocationConfig, *time.Location) {
+	if p.Limits == nil {
+		return cfg.StorageService.Locations, cfg.Schedule.location()
+	}
+	return StorageServiceLocationConfig{Limits: p.Limits}, ScheduleConfig{Timezone: p.Timezone}.location()
+}
+
+// role returns the role of the lease on the location.
+func (p LocationLeaseParams) role(locationID string) string {
+	if slices.Contains(p.Sources, locationID) {
+		return locationLeaseSource
+	}
+	return locationLeaseTarget
+}
+
+const AcquireLocationLeasesName = "acquire-location-leases"
+
+// AcquireLocationLeases takes a lease on every location in params, or on none
+// of them if any location is already at its configured limit or has used up
+// its daily transfer budget. Leases are stored in the database so the limits
+// hold across every worker process.
+func (a *App) AcquireLocationLeases(ctx context.Context, params LocationLeaseParams) (*AcquireLocationLeasesResult, error) {
+	logger := activity.GetLogger(ctx)
+	result := &AcquireLocationLeasesResult{}
+
+	for _, id := range params.LocationIDs {
+		if err := a.removeStaleLocationLeases(ctx, id, params.Holder); err != nil {
+			logger.Warn("Could not check for stale location leases.", "location", id, "error", err)
+		}
+	}
+
+	var size int64
+	if params.AIPUUID != "" {
+		aip, err := a.GetAIPByID(ctx, params.AIPUUID)
+		if err != nil && !errors.Is(err, sql.ErrNoRows) {
+			return nil, fmt.Errorf("get AIP: %w", err)
+		}
+		if aip != nil {
+			size = aip.Size.GetOrZero()
+		}
+	}
+
+	locations, tz := params.limits(a.Config)
+	now := time.Now().In(tz)
+	day := now.Format(time.DateOnly)
+	err := a.DB.RunInTx(ctx, nil, func(ctx context.Context, exec bob.Executor) error {
+		for _, id := range params.LocationIDs {
+			limit := locations.locationLimit(id)
+			if limit.DailyBytes > 0 {
+				if err := checkDailyBudget(ctx, exec, id, params.Holder, day, size, int64(limit.DailyBytes)); err != nil {
+					return err
+				}
+			}
+
+			// With a separate limit for sources, the leases reading from the
+			// location and those writing to it are counted apart.
+			role := params.role(id)
+			maxConcurrent, counted := limit.MaxConcurrent, ""
+			if limit.SourceMaxConcurrent > 0 {
+				counted = role
+				if role == locationLeaseSource {
+					maxConcurrent = limit.SourceMaxConcurrent
+				}
+			}
+
+			// The insert only happens while the location is under its limit.
+			// A holder that already has a lease on it just refreshes it.
+			res, err := exec.ExecContext(ctx, `
+				INSERT INTO location_leases (location_uuid, holder, acquired_at, "size", role)
+				SELECT ?1, ?2, ?3, ?5, ?6
+				WHERE ?4 <= 0 OR (
+					SELECT COUNT(*) FROM location_leases
+					WHERE location_uuid = ?1 AND holder != ?2 AND (?7 = '' OR (role = 'source') = (?7 = 'source'))
+				) < ?4
+				ON CONFLICT (location_uuid, holder) DO UPDATE SET acquired_at = excluded.acquired_at, "size" = excluded."size", role = excluded.role`,
+				id, params.Holder, now.Format(time.RFC3339), maxConcurrent, size, role, counted,
+			)
+			if err != nil {
+				return err
+			}
+			n, err := res.RowsAffected()
+			if err != nil {
+				return err
+			}
+			if n == 0 {
+				return errLocationLimitReached
+			}
+		}
+		return nil
+	})
+	if errors.Is(err, errLocationLimitReached) {
+		return result, nil
+	}
+	if errors.Is(err, errDailyBudgetReached) {
+		result.WaitUntil = time.Date(now.Year(), now.Month(), now.Day()+1, 0, 0, 0, 0, now.Location())
+		return result, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("acquire location leases: %w", err)
+	}
+
+	result.Acquired = true
+	return result, nil
+}
+
+var (
+	errLocationLimitReached = errors.New("location limit reached")
+	errDailyBudgetReached   = errors.New("daily transfer budget reached")
+)
+
+// checkDailyBudget returns errDailyBudgetReached when transferring size more
+// bytes would exceed the budget of the location for the day. The bytes of the
+// transfers in progress count as used. The first transfer of a day is always
+// allowed, even if the AIP is larger than the budget.
+func checkDailyBudget(ctx context.Context, exec bob.Executor, locationID, holder, day string, size, budget int64) error {
+	rows, err := exec.QueryContext(ctx, `
+		SELECT
+			(SELECT COALESCE(SUM("size"), 0) FROM location_transfers WHERE location_uuid = ?1 AND "day" = ?2) +
+			(SELECT COALESCE(SUM("size"), 0) FROM location_leases WHERE location_uuid = ?1 AND holder != ?3)`,
+		locationID, day, holder,
+	)
+	if err != nil {
+		return err
+	}
+	defer rows.Close() //nolint:errcheck
+
+	var used int64
+	if rows.Next() {
+		if err := rows.Scan(&used); err != nil {
+			return err
+		}
+	}
+	if err := rows.Err(); err != nil {
+		return err
+	}
+	if used > 0 && used+size > budget {
+		return errDailyBudgetReached
+	}
+	return nil
+}
+
+const ReleaseLocationLeasesName = "release-location-leases"
+
+// ReleaseLocationLeases deletes the leases of the holder. When the AIP was
+// transferred, the size recorded in each lease is added to the transfers of
+// the day.
+func (a *App) ReleaseLocationLeases(ctx context.Context, params LocationLeaseParams) error {
+	_, tz := params.limits(a.Config)
+	now := time.Now().In(tz)
+	err := a.DB.RunInTx(ctx, nil, func(ctx context.Context, exec bob.Executor) error {
+		if params.Transferred {
+			leases, err := models.LocationLeases.Query(
+				models.SelectWhere.LocationLeases.Holder.EQ(params.Holder),
+				models.SelectWhere.LocationLeases.LocationUUID.In(params.LocationIDs...),
+			).All(ctx, exec)
+			if err != nil {
+				return err
+			}
+			for _, lease := range leases {
+				_, err := models.LocationTransfers.Insert(&models.LocationTransferSetter{
+					LocationUUID:  omit.From(lease.LocationUUID),
+					AipUUID:       omit.From(params.AIPUUID),
+					Size:          omit.From(lease.Size),
+					Day:           omit.From(now.Format(time.DateOnly)),
+					TransferredAt: omit.From(now.Format(time.RFC3339)),
+				}).Exec(ctx, exec)
+				if err != nil {
+					return err
+				}
+			}
+		}
+
+		_, err := models.LocationLeases.Delete(
+			models.DeleteWhere.LocationLeases.Holder.EQ(params.Holder),
+			models.DeleteWhere.LocationLeases.LocationUUID.In(params.LocationIDs...),
+		).Exec(ctx, exec)
+		return err
+	})
+	if err != nil {
+		return fmt.Errorf("release location leases: %w", err)
+	}
+	return nil
+}
+
+// removeStaleLocationLeases deletes leases on the location held by workflows
+// that are no longer running, e.g. after they were terminated before they
+// could release them.
+func (a *App) removeStaleLocationLeases(ctx context.Context, locationID, holder string) error {
+	leases, err := models.LocationLeases.Query(
+		models.SelectWhere.LocationLeases.LocationUUID.EQ(locationID),
+		models.SelectWhere.LocationLeases.Holder.NE(holder),
+	).All(ctx, a.DB)
+	if err != nil {
+		return err
+	}
+
+	var stale models.LocationLeaseSlice
+	for _, lease := range leases {
+		desc, err := a.Tc.DescribeWorkflowExecution(ctx, lease.Holder, "")
+		var notFound *serviceerror.NotFound
+		if errors.As(err, &notFound) {
+			stale = append(stale, lease)
+			continue
+		}
+		if err != nil {
+			return err
+		}
+		if desc.GetWorkflowExecutionInfo().GetStatus() != enums.WORKFLOW_EXECUTION_STATUS_RUNNING {
+			stale = append(stale, lease)
+		}
+	}
+	if len(stale) == 0 {
+		return nil
+	}
+
+	activity.GetLogger(ctx).Info("Removing stale location leases.", "location", locationID, "count", len(stale))
+	return stale.DeleteAll(ctx, a.DB)
+}
@@ -0,0 +1,225 @@
+package application
+
+import (
+	"testing"
+
+	"github.com/aarondl/opt/omit"
+	"github.com/aarondl/opt/omitnull"
+	"github.com/stretchr/testify/mock"
+	"go.temporal.io/api/enums/v1"
+	"go.temporal.io/api/serviceerror"
+	"go.temporal.io/api/workflow/v1"
+	"go.temporal.io/api/workflowservice/v1"
+	"go.temporal.io/sdk/activity"
+	"go.temporal.io/sdk/mocks"
+	"go.temporal.io/sdk/testsuite"
+	"gotest.tools/v3/assert"
+
+	"github.com/artefactual-labs/migrate/internal/database/gen/models"
+)
+
+func TestLocationLeases(t *testing.T) {
+	t.Parallel()
+
+	const (
+		source = "source-location"
+		target = "target-location"
+	)
+
+	// setup returns an App whose Temporal client reports every lease holder as
+	// running, and an activity environment to run the lease activities in.
+	setup := func(t *testing.T) (*App, *testsuite.TestActivityEnvironment) {
+		app := newTestApp(t)
+		tc := mocks.NewClient(t)
+		tc.On("DescribeWorkflowExecution", mock.Anything, mock.Anything, "").Return(
+			&workflowservice.DescribeWorkflowExecutionResponse{
+				WorkflowExecutionInfo: &workflow.WorkflowExecutionInfo{Status: enums.WORKFLOW_EXECUTION_STATUS_RUNNING},
+			}, nil,
+		).Maybe()
+		app.Tc = tc
+
+		var suite testsuite.WorkflowTestSuite
+		env := suite.NewTestActivityEnvironment()
+		env.RegisterActivityWithOptions(app.AcquireLocationLeases, activity.RegisterOptions{Name: AcquireLocationLeasesName})
+		env.RegisterActivityWithOptions(app.ReleaseLocationLeases, activity.RegisterOptions{Name: ReleaseLocationLeasesName})
+		return app, env
+	}
+
+	acquire := func(t *testing.T, env *testsuite.TestActivityEnvironment, params LocationLeaseParams) AcquireLocationLeasesResult {
+		t.Helper()
+		val, err := env.ExecuteActivity(AcquireLocationLeasesName, params)
+		assert.NilError(t, err)
+		var res AcquireLocationLeasesResult
+		assert.NilError(t, val.Get(&res))
+		return res
+	}
+
+	release := func(t *testing.T, env *testsuite.TestActivityEnvironment, params LocationLeaseParams) {
+		t.Helper()
+		_, err := env.ExecuteActivity(ReleaseLocationLeasesName, params)
+		assert.NilError(t, err)
+	}
+
+	leaseParams := func(holder string, limits ...LocationLimit) LocationLeaseParams {
+		return LocationLeaseParams{
+			Holder:      holder,
+			LocationIDs: []string{source, target},
+			Sources:     []string{source},
+			Limits:      limits,
+		}
+	}
+
+	t.Run("Waits while a location is at its limit", func(t *testing.T) {
+		t.Parallel()
+
+		app, env := setup(t)
+		limits := []LocationLimit{{LocationID: source}, {LocationID: target, MaxConcurrent: 1}}
+
+		assert.Equal(t, acquire(t, env, leaseParams("wf-1", limits...)).Acquired, true)
+		// The holder of a lease refreshes it.
+		assert.Equal(t, acquire(t, env, leaseParams("wf-1", limits...)).Acquired, true)
+		assert.Equal(t, acquire(t, env, leaseParams("wf-2", limits...)).Acquired, false)
+
+		// Nothing is leased when a location is at its limit.
+		leases, err := models.LocationLeases.Query().All(t.Context(), app.DB)
+		assert.NilError(t, err)
+		assert.Equal(t, len(leases), 2)
+
+		release(t, env, leaseParams("wf-1", limits...))
+		assert.Equal(t, acquire(t, env, leaseParams("wf-2", limits...)).Acquired, true)
+	})
+
+	t.Run("Counts sources and targets together without a source limit", func(t *testing.T) {
+		t.Parallel()
+
+		_, env := setup(t)
+		limit := LocationLimit{LocationID: source, MaxConcurrent: 1}
+
+		assert.Equal(t, acquire(t, env, leaseParams("wf-1", limit)).Acquired, true)
+		// wf-2 writes to the location wf-1 reads from.
+		assert.Equal(t, acquire(t, env, LocationLeaseParams{
+			Holder:      "wf-2",
+			LocationIDs: []string{source},
+			Limits:      []LocationLimit{limit},
+		}).Acquired, false)
+	})
+
+	t.Run("Limits sources separately", func(t *testing.T) {
+		t.Parallel()
+
+		app, env := setup(t)
+		limit := LocationLimit{LocationID: source, MaxConcurrent: 1, SourceMaxConcurrent: 2}
+		asTarget := func(holder string) LocationLeaseParams {
+			return LocationLeaseParams{Holder: holder, LocationIDs: []string{source}, Limits: []LocationLimit{limit}}
+		}
+
+		assert.Equal(t, acquire(t, env, leaseParams("wf-1", limit)).Acquired, true)
+		assert.Equal(t, acquire(t, env, leaseParams("wf-2", limit)).Acquired, true)
+		assert.Equal(t, acquire(t, env, leaseParams("wf-3", limit)).Acquired, false)
+		assert.Equal(t, acquire(t, env, asTarget("wf-4")).Acquired, true)
+		assert.Equal(t, acquire(t, env, asTarget("wf-5")).Acquired, false)
+
+		lease, err := models.LocationLeases.Query(
+			models.SelectWhere.LocationLeases.Holder.EQ("wf-4"),
+		).One(t.Context(), app.DB)
+		assert.NilError(t, err)
+		assert.Equal(t, lease.Role, locationLeaseTarget)
+	})
+
+	t.Run("Uses the limits of the worker for older leases", func(t *testing.T) {
+		t.Parallel()
+
+		app, env := setup(t)
+		app.Config.StorageService.Locations.Limits = []LocationLimit{{LocationID: target, MaxConcurrent: 1}}
+
+		assert.Equal(t, acquire(t, env, leaseParams("wf-1")).Acquired, true)
+		assert.Equal(t, acquire(t, env, leaseParams("wf-2")).Acquired, false)
+	})
+
+	t.Run("Records the transfer of the AIP on release", func(t *testing.T) {
+		t.Parallel()
+
+		app, env := setup(t)
+		_, err := models.Aips.Insert(&models.AipSetter{
+			UUID:   omit.From("2faa61dc-ed33-49f4-8b36-954f203bab4a"),
+			Status: omit.From(string(AIPStatusNew)),
+			Size:   omitnull.From(int64(1024)),
+		}).Exec(t.Context(), app.DB)
+		assert.NilError(t, err)
+
+		params := LocationLeaseParams{
+			Holder:      "wf-1",
+			LocationIDs: []string{target},
+			Limits:      []LocationLimit{{LocationID: target, DailyBytes: 1500}},
+		}
+		params.AIPUUID = "2faa61dc-ed33-49f4-8b36-954f203bab4a"
+		assert.Equal(t, acquire(t, env, params).Acquired, true)
+
+		params.Transferred = true
+		release(t, env, params)
+
+		leases, err := models.LocationLeases.Query().All(t.Context(), app.DB)
+		assert.NilError(t, err)
+		assert.Equal(t, len(leases), 0)
+
+		transfers, err := models.LocationTransfers.Query().All(t.Context(), app.DB)
+		assert.NilError(t, err)
+		assert.Equal(t, len(transfers), 1)
+		assert.Equal(t, transfers[0].LocationUUID, target)
+		assert.Equal(t, transfers[0].Size, int64(1024))
+
+		// The budget of the day is used up.
+		params.Holder = "wf-2"
+		res := acquire(t, env, params)
+		assert.Equal(t, res.Acquired, false)
+		assert.Assert(t, !res.WaitUntil.IsZero())
+	})
+
+	t.Run("Removes the leases of workflows no longer running", func(t *testing.T) {
+		t.Parallel()
+
+		app := newTestApp(t)
+		tc := mocks.NewClient(t)
+		tc.On("DescribeWorkflowExecution", mock.Anything, "wf-running", "").Return(
+			&workflowservice.DescribeWorkflowExecutionResponse{
+				WorkflowExecutionInfo: &workflow.WorkflowExecutionInfo{Status: enums.WORKFLOW_EXECUTION_STATUS_RUNNING},
+			}, nil,
+		)
+		tc.On("DescribeWorkflowExecution", mock.Anything, "wf-terminated", "").Return(
+			&workflowservice.DescribeWorkflowExecutionResponse{
+				WorkflowExecutionInfo: &workflow.WorkflowExecutionInfo{Status: enums.WORKFLOW_EXECUTION_STATUS_TERMINATED},
+			}, nil,
+		)
+		tc.On("DescribeWorkflowExecution", mock.Anything, "wf-gone", "").Return(
+			nil, serviceerror.NewNotFound("workflow not found"),
+		)
+		app.Tc = tc
+
+		for _, holder := range []string{"wf-running", "wf-terminated", "wf-gone"} {
+			_, err := models.LocationLeases.Insert(&models.LocationLeaseSetter{
+				LocationUUID: omit.From(target),
+				Holder:       omit.From(holder),
+				AcquiredAt:   omit.From("2025-01-01T00:00:00Z"),
+			}).Exec(t.Context(), app.DB)
+			assert.NilError(t, err)
+		}
+
+		var suite testsuite.WorkflowTestSuite
+		env := suite.NewTestActivityEnvironment()
+		env.RegisterActivityWithOptions(app.AcquireLocationLeases, activity.RegisterOptions{Name: AcquireLocationLeasesName})
+
+		assert.Equal(t, acquire(t, env, LocationLeaseParams{
+			Holder:      "wf-1",
+			LocationIDs: []string{target},
+			Limits:      []LocationLimit{{LocationID: target, MaxConcurrent: 2}},
+		}).Acquired, true)
+
+		leases, err := models.LocationLeases.Query().All(t.Context(), app.DB)
+		assert.NilError(t, err)
+		var holders []string
+		for _, lease := range leases {
+			holders = append(holders, lease.Holder)
+		}
+		assert.DeepEqual(t, holders, []string{"wf-running", "wf-1"})
+	})
+}
@@ -0,0 +1,101 @@
+package application
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+
+	"github.com/aarondl/opt/omit"
+
+	"github.com/artefactual-labs/migrate/internal/database/gen/models"
+	"github.com/artefactual-labs/migrate/internal/storage_service"
+)
+
+// mapping returns the mapping of the location an AIP is stored in: the
+// mapping with that source location or, for AIPs already moved, the first
+// one with that move target.
+func (c StorageServiceLocationConfig) mapping(locationID string) (LocationMapping, bool) {
+	if i := slices.IndexFunc(c.Mappings, func(m LocationMapping) bool { return m.SourceLocationID == locationID }); i >= 0 {
+		return c.Mappings[i], true
+	}
+	if i := slices.IndexFunc(c.Mappings, func(m LocationMapping) bool { return m.MoveTargetLocationID == locationID }); i >= 0 {
+		return c.Mappings[i], true
+	}
+	return LocationMapping{}, false
+}
+
+// forMapping returns the locations used for the AIPs of the mapping.
+func (c StorageServiceLocationConfig) forMapping(m LocationMapping) StorageServiceLocationConfig {
+	c.SourceLocationID = m.SourceLocationID
+	c.MoveTargetLocationID = m.MoveTargetLocationID
+	if len(m.ReplicationTargets) > 0 {
+		c.ReplicationTargets = m.ReplicationTargets
+	}
+	c.Mappings = nil
+	return c
+}
+
+// forAIP returns the locations used for the AIP: those of the mapping
+// recorded by InitAIPInDatabase, or the configured ones without mappings,
+// with the move target overridden by the input file.
+func (c StorageServiceLocationConfig) forAIP(aip *models.Aip) StorageServiceLocationConfig {
+	locations := c
+	if aip.SourceLocationUUID != "" {
+		m := LocationMapping{
+			SourceLocationID:     aip.SourceLocationUUID,
+			MoveTargetLocationID: aip.MoveTargetLocationUUID,
+		}
+		if i := slices.IndexFunc(c.Mappings, func(l LocationMapping) bool { return l.SourceLocationID == m.SourceLocationID }); i >= 0 {
+			m.ReplicationTargets = c.Mappings[i].ReplicationTargets
+		}
+		locations = c.forMapping(m)
+	}
+	if aip.MoveTargetOverride != "" {
+		locations.MoveTargetLocationID = aip.MoveTargetOverride
+	}
+	return locations
+}
+
+// mapAIP records the mapping of the location the package is stored in, or
+// gives the AIP the unmapped-location status when there is none. It reports
+// whether the AIP is mapped.
+func (a *App) mapAIP(ctx context.Context, locations StorageServiceLocationConfig, aip *models.Aip, pkg *storage_service.Package) (bool, error) {
+	location := storage_service.ResourceUUID(pkg.CurrentLocation)
+	m, ok := locations.mapping(location)
+	if !ok {
+		e := StartEvent(ActionFind)
+		msg := fmt.Sprintf("AIP stored in location %s, which is not mapped", location)
+		e.AddDetail(msg)
+		a.AddAIPError(ctx, aip, msg)
+		if err := EndEvent(ctx, AIPStatusUnmappedLocation, a, e, aip); err != nil {
+			return false, err
+		}
+		return false, aip.Reload(ctx, a.DB)
+	}
+
+	setter := &models.AipSetter{
+		SourceLocationUUID:     omit.From(m.SourceLocationID),
+		MoveTargetLocationUUID: omit.From(m.MoveTargetLocationID),
+	}
+	// The mappings may have changed since the AIP was rejected.
+	if aip.Status == string(AIPStatusUnmappedLocation) {
+		setter.Status = omit.From(string(AIPStatusNew))
+	}
+	if err := a.UpdateAIP(ctx, aip.ID, setter); err != nil {
+		return false, err
+	}
+	return true, aip.Reload(ctx, a.DB)
+}
+
+// getPackage returns the package of the AIP, or nil when the Storage Service
+// does not know it.
+func (a *App) getPackage(ctx context.Context, aipUUID string) (*storage_service.Package, error) {
+	pkg, err := a.StorageClient.Packages.GetByID(ctx, aipUUID)
+	if errors.Is(err, storage_service.ErrNotFound) {
+		return nil, nil
+	} else if err != nil {
+		return nil, fmt.Errorf("get package: %w", err)
+	}
+	return pkg, nil
+}
@@ -0,0 +1,72 @@
+package application
+
+import (
+	"testing"
+
+	"gotest.tools/v3/assert"
+
+	"github.com/artefactual-labs/migrate/internal/database/gen/models"
+)
+
+func TestLocationMapping(t *testing.T) {
+	t.Parallel()
+
+	locations := StorageServiceLocationConfig{
+		ReplicationTargets: []ReplicationTarget{{ID: "replica-1"}},
+		Mappings: []LocationMapping{
+			{SourceLocationID: "legacy-1", MoveTargetLocationID: "new-1"},
+			{SourceLocationID: "legacy-2", MoveTargetLocationID: "new-1", ReplicationTargets: []ReplicationTarget{{ID: "replica-2"}}},
+			{SourceLocationID: "new-1", MoveTargetLocationID: "new-2"},
+		},
+	}
+
+	t.Run("Source location", func(t *testing.T) {
+		t.Parallel()
+
+		m, ok := locations.mapping("legacy-2")
+		assert.Assert(t, ok)
+		assert.DeepEqual(t, m, locations.Mappings[1])
+	})
+
+	t.Run("Source location first", func(t *testing.T) {
+		t.Parallel()
+
+		m, ok := locations.mapping("new-1")
+		assert.Assert(t, ok)
+		assert.DeepEqual(t, m, locations.Mappings[2])
+	})
+
+	t.Run("Move target location", func(t *testing.T) {
+		t.Parallel()
+
+		m, ok := locations.mapping("new-2")
+		assert.Assert(t, ok)
+		assert.DeepEqual(t, m, locations.Mappings[2])
+	})
+
+	t.Run("Unmapped", func(t *testing.T) {
+		t.Parallel()
+
+		_, ok := locations.mapping("elsewhere")
+		assert.Assert(t, !ok)
+	})
+
+	t.Run("AIP locations", func(t *testing.T) {
+		t.Parallel()
+
+		got := locations.forAIP(&models.Aip{SourceLocationUUID: "legacy-2", MoveTargetLocationUUID: "new-1"})
+		assert.Equal(t, got.SourceLocationID, "legacy-2")
+		assert.Equal(t, got.MoveTargetLocationID, "new-1")
+		assert.DeepEqual(t, got.ReplicationTargets, []ReplicationTarget{{ID: "replica-2"}})
+		assert.Assert(t, got.Mappings == nil)
+
+		got = locations.forAIP(&models.Aip{SourceLocationUUID: "legacy-1", MoveTargetLocationUUID: "new-1"})
+		assert.DeepEqual(t, got.ReplicationTargets, []ReplicationTarget{{ID: "replica-1"}})
+
+		got = locations.forAIP(&models.Aip{})
+		assert.Equal(t, len(got.Mappings), 3)
+
+		got = locations.forAIP(&models.Aip{SourceLocationUUID: "legacy-1", MoveTargetLocationUUID: "new-1", MoveTargetOverride: "new-3"})
+		assert.Equal(t, got.MoveTargetLocationID, "new-3")
+	})
+}
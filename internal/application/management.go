@@ -0,0 +1,219 @@
+package application
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os/exec"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// ManagementRunner builds the commands running manage.py management commands
+// wherever the Storage Service (or the Archivematica dashboard) is deployed.
+// The implementation is chosen by storage_service.management.mode.
+type ManagementRunner interface {
+	// Command returns the command running manage.py with args. The command
+	// is killed when ctx is done.
+	Command(ctx context.Context, args ...string) *exec.Cmd
+}
+
+// NewManagementRunner returns the runner of the configured mode.
+func NewManagementRunner(m StorageServiceManagementConfig) (ManagementRunner, error) {
+	switch m.Mode {
+	case ManagementModeDocker:
+		if m.Docker.Container == "" {
+			return nil, errors.New("management.docker.container is required")
+		}
+		if m.Docker.ManagePath == "" {
+			return nil, errors.New("management.docker.manage_path is required")
+		}
+		return dockerRunner{m.Docker}, nil
+	case ManagementModeHost:
+		if m.Host.ManagePath == "" {
+			return nil, errors.New("management.host.manage_path is required")
+		}
+		return hostRunner{m.Host}, nil
+	case ManagementModeKubectl:
+		if m.Kubectl.Pod == "" {
+			return nil, errors.New("management.kubectl.pod is required")
+		}
+		if m.Kubectl.ManagePath == "" {
+			return nil, errors.New("management.kubectl.manage_path is required")
+		}
+		return kubectlRunner{m.Kubectl}, nil
+	case ManagementModeSSH:
+		if m.SSH.Host == "" {
+			return nil, errors.New("management.ssh.host is required")
+		}
+		if m.SSH.ManagePath == "" {
+			return nil, errors.New("management.ssh.manage_path is required")
+		}
+		return sshRunner{m.SSH}, nil
+	default:
+		return nil, fmt.Errorf("unsupported storage service management mode %q", m.Mode)
+	}
+}
+
+// managementWaitDelay bounds the wait for the output of a killed command,
+// which child processes may keep open.
+const managementWaitDelay = 10 * time.Second
+
+// managementCommand is a management command ready to run.
+type managementCommand struct {
+	*exec.Cmd
+	ctx     context.Context
+	cancel  context.CancelCauseFunc
+	timeout time.Duration
+}
+
+var errManagementTimeout = errors.New("management command timed out")
+
+// command returns the management command running manage.py with args. It is
+// killed when ctx is done or once the configured timeout elapses.
+func (m StorageServiceManagementConfig) command(ctx context.Context, args ...string) (*managementCommand, error) {
+	runner, err := NewManagementRunner(m)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx, cancel := context.WithCancelCause(ctx)
+	cmd := runner.Command(ctx, args...)
+	cmd.WaitDelay = managementWaitDelay
+
+	return &managementCommand{Cmd: cmd, ctx: ctx, cancel: cancel, timeout: time.Duration(m.Timeout)}, nil
+}
+
+// CombinedOutput runs the command and returns its standard output and
+// standard error. The timeout counts from here.
+func (c *managementCommand) CombinedOutput() ([]byte, error) {
+	defer c.cancel(nil)
+	if c.timeout > 0 {
+		timer := time.AfterFunc(c.timeout, func() { c.cancel(errManagementTimeout) })
+		defer timer.Stop()
+	}
+
+	output, err := c.Cmd.CombinedOutput()
+	if err != nil && c.ctx.Err() != nil {
+		if cause := context.Cause(c.ctx); errors.Is(cause, errManagementTimeout) {
+			err = fmt.Errorf("%w after %s: %w", cause, c.timeout, err)
+		} else {
+			err = fmt.Errorf("management command cancelled: %w", err)
+		}
+	}
+	return output, err
+}
+
+type dockerRunner struct {
+	cfg StorageServiceDockerConfig
+}
+
+func (r dockerRunner) Command(ctx context.Context, args ...string) *exec.Cmd {
+	return exec.CommandContext(ctx, "docker", append([]string{"exec", r.cfg.Container, r.cfg.ManagePath}, args...)...)
+}
+
+type hostRunner struct {
+	cfg StorageServiceHostConfig
+}
+
+func (r hostRunner) Command(ctx context.Context, args ...string) *exec.Cmd {
+	pythonPath := r.cfg.PythonPath
+	if pythonPath == "" {
+		pythonPath = "python3"
+	}
+	cmd := exec.CommandContext(ctx, pythonPath, append([]string{r.cfg.ManagePath}, args...)...)
+	cmd.Env = cmd.Environ()
+	for _, key := range sortedEnvKeys(r.cfg.Environment) {
+		cmd.Env = append(cmd.Env, fmt.Sprintf("%s=%s", key, r.cfg.Environment[key]))
+	}
+	return cmd
+}
+
+type kubectlRunner struct {
+	cfg StorageServiceKubectlConfig
+}
+
+func (r kubectlRunner) Command(ctx context.Context, args ...string) *exec.Cmd {
+	kubectlPath := r.cfg.KubectlPath
+	if kubectlPath == "" {
+		kubectlPath = "kubectl"
+	}
+	var kargs []string
+	if r.cfg.Context != "" {
+		kargs = append(kargs, "--context", r.cfg.Context)
+	}
+	if r.cfg.Namespace != "" {
+		kargs = append(kargs, "--namespace", r.cfg.Namespace)
+	}
+	kargs = append(kargs, "exec", r.cfg.Pod)
+	if r.cfg.Container != "" {
+		kargs = append(kargs, "--container", r.cfg.Container)
+	}
+	kargs = append(kargs, "--", r.cfg.ManagePath)
+	return exec.CommandContext(ctx, kubectlPath, append(kargs, args...)...)
+}
+
+type sshRunner struct {
+	cfg StorageServiceSSHConfig
+}
+
+func (r sshRunner) Command(ctx context.Context, args ...string) *exec.Cmd {
+	sshPath := r.cfg.SSHPath
+	if sshPath == "" {
+		sshPath = "ssh"
+	}
+	pythonPath := r.cfg.PythonPath
+	if pythonPath == "" {
+		pythonPath = "python3"
+	}
+
+	// Never prompt, a worker has no one to answer.
+	sargs := []string{"-o", "BatchMode=yes"}
+	if r.cfg.Port != 0 {
+		sargs = append(sargs, "-p", strconv.Itoa(r.cfg.Port))
+	}
+	if r.cfg.IdentityFile != "" {
+		sargs = append(sargs, "-i", r.cfg.IdentityFile)
+	}
+	for _, opt := range r.cfg.Options {
+		sargs = append(sargs, "-o", opt)
+	}
+	host := r.cfg.Host
+	if r.cfg.User != "" {
+		host = r.cfg.User + "@" + host
+	}
+	sargs = append(sargs, host, "--")
+
+	// ssh passes a single command line to the remote shell.
+	remote := []string{}
+	if len(r.cfg.Environment) > 0 {
+		remote = append(remote, "env")
+		for _, key := range sortedEnvKeys(r.cfg.Environment) {
+			remote = append(remote, shellQuote(key+"="+r.cfg.Environment[key]))
+		}
+	}
+	remote = append(remote, shellQuote(pythonPath), shellQuote(r.cfg.ManagePath))
+	for _, arg := range args {
+		remote = append(remote, shellQuote(arg))
+	}
+	return exec.CommandContext(ctx, sshPath, append(sargs, strings.Join(remote, " "))...)
+}
+
+func sortedEnvKeys(env map[string]string) []string {
+	keys := make([]string, 0, len(env))
+	for key := range env {
+		keys = append(keys, key)
+	}
+	slices.Sort(keys)
+	return keys
+}
+
+// shellQuote quotes s for a POSIX shell.
+func shellQuote(s string) string {
+	if s != "" && strings.Trim(s, "abcdefghijklmnopqrstuvwxyzABCDEFGHIJKLMNOPQRSTUVWXYZ0123456789-_./=:@,+") == "" {
+		return s
+	}
+	return "'" + strings.ReplaceAll(s, "'", `'\''`) + "'"
+}
@@ -0,0 +1,142 @@
+package application
+
+import (
+	"context"
+	"os"
+	"strings"
+	"testing"
+	"time"
+
+	"gotest.tools/v3/assert"
+	"gotest.tools/v3/fs"
+)
+
+// fakeBinaries puts scripts named after the client binaries first on PATH.
+// They print their name and arguments, one per line, or sleep when one of the
+// arguments mentions "sleep".
+func fakeBinaries(t *testing.T) {
+	t.Helper()
+
+	script := `#!/bin/sh
+for arg in "$@"; do
+	case "$arg" in *sleep*) exec sleep 10 ;; esac
+done
+echo "$(basename "$0")"
+for arg in "$@"; do
+	echo "$arg"
+done
+`
+	dir := fs.NewDir(t, "bin",
+		fs.WithFile("docker", script, fs.WithMode(0o755)),
+		fs.WithFile("kubectl", script, fs.WithMode(0o755)),
+		fs.WithFile("ssh", script, fs.WithMode(0o755)),
+		fs.WithFile("python3", script, fs.WithMode(0o755)),
+	)
+	t.Setenv("PATH", dir.Path()+string(os.PathListSeparator)+os.Getenv("PATH"))
+}
+
+func TestManagementRunners(t *testing.T) {
+	fakeBinaries(t)
+
+	args := []string{"create_aip_replicas", "--aip-uuid", "6a9f0f33-2d1c-4c4e-9a5a-6c1d2b3e4f50"}
+	for _, tc := range []struct {
+		name string
+		cfg  StorageServiceManagementConfig
+		want []string
+	}{
+		{
+			name: "Runs manage.py in a Docker container",
+			cfg: StorageServiceManagementConfig{
+				Docker: StorageServiceDockerConfig{Container: "ss", ManagePath: "/src/manage.py"},
+			},
+			want: []string{"docker", "exec", "ss", "/src/manage.py"},
+		},
+		{
+			name: "Runs manage.py on the host",
+			cfg: StorageServiceManagementConfig{
+				Mode: ManagementModeHost,
+				Host: StorageServiceHostConfig{ManagePath: "/src/manage.py"},
+			},
+			want: []string{"python3", "/src/manage.py"},
+		},
+		{
+			name: "Runs manage.py with kubectl exec",
+			cfg: StorageServiceManagementConfig{
+				Mode: ManagementModeKubectl,
+				Kubectl: StorageServiceKubectlConfig{
+					Namespace:  "archivematica",
+					Pod:        "deployment/storage-service",
+					Container:  "app",
+					ManagePath: "/src/manage.py",
+				},
+			},
+			want: []string{"kubectl", "--namespace", "archivematica", "exec", "deployment/storage-service", "--container", "app", "--", "/src/manage.py"},
+		},
+		{
+			name: "Runs manage.py over SSH",
+			cfg: StorageServiceManagementConfig{
+				Mode: ManagementModeSSH,
+				SSH: StorageServiceSSHConfig{
+					Host:        "ss.example.com",
+					User:        "archivematica",
+					Port:        2222,
+					ManagePath:  "/opt/storage service/manage.py",
+					Environment: map[string]string{"DJANGO_SETTINGS_MODULE": "settings.local"},
+				},
+			},
+			want: []string{
+				"ssh", "-o", "BatchMode=yes", "-p", "2222", "archivematica@ss.example.com", "--",
+				"env DJANGO_SETTINGS_MODULE=settings.local python3 '/opt/storage service/manage.py' create_aip_replicas --aip-uuid 6a9f0f33-2d1c-4c4e-9a5a-6c1d2b3e4f50",
+			},
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.NilError(t, tc.cfg.applyDefaults("management"))
+			cmd, err := tc.cfg.command(t.Context(), args...)
+			assert.NilError(t, err)
+
+			output, err := cmd.CombinedOutput()
+			assert.NilError(t, err, string(output))
+
+			want := tc.want
+			if tc.cfg.Mode != ManagementModeSSH {
+				want = append(want, args...)
+			}
+			assert.DeepEqual(t, strings.Split(strings.TrimSpace(string(output)), "\n"), want)
+		})
+	}
+
+	t.Run("Kills the command after the timeout", func(t *testing.T) {
+		cfg := StorageServiceManagementConfig{
+			Mode:    ManagementModeKubectl,
+			Kubectl: StorageServiceKubectlConfig{Pod: "storage-service", ManagePath: "/src/manage.py"},
+			Timeout: Duration(100 * time.Millisecond),
+		}
+		cmd, err := cfg.command(t.Context(), "sleep")
+		assert.NilError(t, err)
+
+		start := time.Now()
+		_, err = cmd.CombinedOutput()
+		assert.ErrorIs(t, err, errManagementTimeout)
+		assert.Assert(t, time.Since(start) < 5*time.Second)
+	})
+
+	t.Run("Kills the command when the context is cancelled", func(t *testing.T) {
+		cfg := StorageServiceManagementConfig{
+			Mode: ManagementModeSSH,
+			SSH:  StorageServiceSSHConfig{Host: "ss.example.com", ManagePath: "/src/manage.py"},
+		}
+		ctx, cancel := context.WithTimeout(t.Context(), 100*time.Millisecond)
+		defer cancel()
+		cmd, err := cfg.command(ctx, "sleep")
+		assert.NilError(t, err)
+
+		_, err = cmd.CombinedOutput()
+		assert.ErrorContains(t, err, "management command cancelled")
+	})
+
+	t.Run("Requires the settings of the mode", func(t *testing.T) {
+		_, err := NewManagementRunner(StorageServiceManagementConfig{Mode: ManagementModeSSH})
+		assert.Error(t, err, "management.ssh.host is required")
+	})
+}
@@ -5,11 +5,14 @@ import (
 	"errors"
 	"fmt"
 	"log/slog"
+	"slices"
 	"strings"
 	"time"
 
 	"github.com/aarondl/opt/omitnull"
 	"github.com/cenkalti/backoff/v4"
+	"go.temporal.io/sdk/activity"
+	"go.temporal.io/sdk/temporal"
 
 	"github.com/artefactual-labs/migrate/internal/database/gen/models"
 	"github.com/artefactual-labs/migrate/internal/storage_service"
@@ -19,17 +22,25 @@ const MoveActivityName = "Move Activity"
 
 type MoveActivityParams struct {
 	UUID string `json:"uuid"`
+
+	// Settings of the workflow, nil for runs submitted before they were
+	// passed to the activity.
+	Settings *WorkflowSettings `json:"settings,omitempty"`
 }
 type MoveActivityResult struct {
 	Status string
 }
 
+// MoveA moves the AIP and waits for the move to complete. New workflows use
+// StartMoveA and PollMoveA instead.
 func (a *App) MoveA(ctx context.Context, params MoveActivityParams) (*MoveActivityResult, error) {
 	aip, err := a.GetAIPByID(ctx, params.UUID)
 	if err != nil {
 		return nil, err
 	}
-	err = move(ctx, a.logger, a, a.StorageClient, aip)
+	stopHeartbeat := heartbeat(ctx)
+	err = move(ctx, a.logger, a, a.StorageClient, a.workflowSettings(params.Settings).Locations, aip)
+	stopHeartbeat()
 	if err != nil {
 		return nil, err
 	}
@@ -42,9 +53,180 @@ func (a *App) MoveA(ctx context.Context, params MoveActivityParams) (*MoveActivi
 	return result, nil
 }
 
+const StartMoveActivityName = "start-move"
+
+type StartMoveActivityResult struct {
+	Status string
+	// Done is set when there is nothing left to poll for, either because the
+	// AIP is already in the target location or because the request failed.
+	Done bool
+	// Started is when the move began, used to record the move event.
+	Started time.Time
+}
+
+// movePendingStatuses are the statuses of an AIP whose move has not been
+// requested in its current batch.
+var movePendingStatuses = []string{
+	string(AIPStatusNew),
+	string(AIPStatusFound),
+	string(AIPStatusFixityChecked),
+	string(AIPStatusWaitingForWindow),
+	string(AIPStatusCancelled),
+}
+
+// StartMoveA asks the Storage Service to move the AIP to the move target
+// location, without waiting for the move to complete. The workflow follows up
+// with PollMoveA.
+func (a *App) StartMoveA(ctx context.Context, params MoveActivityParams) (*StartMoveActivityResult, error) {
+	logger := activity.GetLogger(ctx)
+	aip, err := a.GetAIPByID(ctx, params.UUID)
+	if err != nil {
+		return nil, err
+	}
+
+	e := StartEvent(ActionMove)
+	e.AddDetail(fmt.Sprintf("Moving: %s", aip.UUID))
+	result := &StartMoveActivityResult{Started: e.Start}
+	// The status of an AIP moved in another batch is reset when it joins a
+	// new one, whose move target can differ: it is looked up in the Storage
+	// Service like any other.
+	if aip.Moved && !slices.Contains(movePendingStatuses, aip.Status) {
+		result.Status, result.Done = aip.Status, true
+		if aip.Status == string(AIPStatusIndexed) {
+			// Re-indexing follows the move, an indexed AIP is still moved.
+			result.Status = string(AIPStatusMoved)
+		}
+		return result, nil
+	}
+
+	target := a.workflowSettings(params.Settings).Locations.forAIP(aip).MoveTargetLocationID
+	ssPackage, err := a.StorageClient.Packages.GetByID(ctx, aip.UUID)
+	if err != nil {
+		return nil, err
+	}
+	if strings.Contains(ssPackage.CurrentLocation, target) && ssPackage.Status == "UPLOADED" {
+		e.AddDetail("AIP already in the desired location")
+		if err := a.UpdateAIP(ctx, aip.ID, &models.AipSetter{
+			CurrentLocation: omitnull.From(ssPackage.CurrentLocation),
+		}); err != nil {
+			return nil, err
+		}
+		if err := EndEvent(ctx, AIPStatusMoved, a, e, aip); err != nil {
+			return nil, err
+		}
+		result.Status, result.Done = string(AIPStatusMoved), true
+		return result, nil
+	}
+
+	// A previous attempt already requested the move, keep polling it.
+	if aip.Status == string(AIPStatusMoving) {
+		logger.Info("AIP last know Status: moving")
+		result.Status = aip.Status
+		return result, nil
+	}
+
+	// The moving status is recorded before the move is requested, so a retry
+	// of this activity polls the move instead of requesting it again.
+	if err := a.UpdateAIPStatus(ctx, aip.ID, AIPStatusMoving); err != nil {
+		return nil, err
+	}
+	if err := a.StorageClient.Packages.Move(ctx, aip.UUID, target); err != nil {
+		if eventErr := EndEventErr(ctx, a, e, aip, "MOVE operation failed: "+err.Error()); eventErr != nil {
+			return nil, eventErr
+		}
+		result.Status, result.Done = string(AIPStatusFailed), true
+		return result, nil
+	}
+
+	result.Status = string(AIPStatusMoving)
+	return result, nil
+}
+
+const PollMoveActivityName = "poll-move"
+
+type PollMoveActivityParams struct {
+	UUID    string
+	Started time.Time
+	// GiveUp makes the poll fail the move if it is still in progress.
+	GiveUp bool
+
+	// Settings of the workflow, nil for runs submitted before they were
+	// passed to the activity.
+	Settings *WorkflowSettings
+}
+
+type PollMoveActivityResult struct {
+	Status string
+	Done   bool
+}
+
+// PollMoveA checks the Storage Service once for the status of a move started
+// by StartMoveA and records its outcome when it is over.
+func (a *App) PollMoveA(ctx context.Context, params PollMoveActivityParams) (*PollMoveActivityResult, error) {
+	aip, err := a.GetAIPByID(ctx, params.UUID)
+	if err != nil {
+		return nil, err
+	}
+
+	e := Event{Action: ActionMove, Start: params.Started}
+	e.AddDetail(fmt.Sprintf("Moving: %s", aip.UUID))
+
+	ssPackage, err := a.StorageClient.Packages.GetByID(ctx, aip.UUID)
+	if err != nil {
+		// The poll is retried, the move only fails when it cannot be.
+		if !params.GiveUp && !lastAttempt(activity.GetInfo(ctx)) {
+			return nil, err
+		}
+		if eventErr := EndEventErr(ctx, a, e, aip, err.Error()); eventErr != nil {
+			return nil, eventErr
+		}
+		return nil, temporal.NewNonRetryableApplicationError(err.Error(), "MovePollingFailed", err)
+	}
+
+	switch {
+	case ssPackage.Status == "MOVING" && !params.GiveUp:
+		if aip.Status != string(AIPStatusMoving) {
+			if err := a.UpdateAIPStatus(ctx, aip.ID, AIPStatusMoving); err != nil {
+				return nil, err
+			}
+		}
+		return &PollMoveActivityResult{Status: string(AIPStatusMoving)}, nil
+	case ssPackage.Status == "MOVING":
+		err := errors.New("move polling timed out")
+		if eventErr := EndEventErr(ctx, a, e, aip, err.Error()); eventErr != nil {
+			return nil, eventErr
+		}
+		return nil, temporal.NewNonRetryableApplicationError(err.Error(), "MovePollingTimeout", err)
+	case ssPackage.Status == "UPLOADED" && strings.Contains(ssPackage.CurrentLocation, a.workflowSettings(params.Settings).Locations.forAIP(aip).MoveTargetLocationID):
+		if err := a.UpdateAIP(ctx, aip.ID, &models.AipSetter{
+			CurrentLocation: omitnull.From(ssPackage.CurrentLocation),
+		}); err != nil {
+			return nil, err
+		}
+		if err := EndEvent(ctx, AIPStatusMoved, a, e, aip); err != nil {
+			return nil, err
+		}
+		return &PollMoveActivityResult{Status: string(AIPStatusMoved), Done: true}, nil
+	default:
+		err := errors.New("Unexpected AIP Status: " + ssPackage.Status)
+		if eventErr := EndEventErr(ctx, a, e, aip, err.Error()); eventErr != nil {
+			return nil, eventErr
+		}
+		return nil, temporal.NewNonRetryableApplicationError(err.Error(), "UnexpectedAIPStatus", err)
+	}
+}
+
+// lastAttempt reports whether the activity will not be retried if it fails.
+func lastAttempt(info activity.Info) bool {
+	return info.RetryPolicy != nil && info.RetryPolicy.MaximumAttempts > 0 && info.Attempt >= info.RetryPolicy.MaximumAttempts
+}
+
 // move moves the AIPs to the desired location and updates their status
 // accordingly.
-func move(ctx context.Context, logger *slog.Logger, a *App, storageClient *storage_service.API, aips ...*models.Aip) error {
+//
+// It polls the move from inside the activity and is only used by MoveA, which
+// is kept for move workflows started before StartMoveA and PollMoveA existed.
+func move(ctx context.Context, logger *slog.Logger, a *App, storageClient *storage_service.API, locations StorageServiceLocationConfig, aips ...*models.Aip) error {
 	for _, aip := range aips {
 		e := StartEvent(ActionMove)
 		e.AddDetail(fmt.Sprintf("Moving: %s", aip.UUID))
@@ -53,11 +235,12 @@ func move(ctx context.Context, logger *slog.Logger, a *App, storageClient *stora
 			continue
 		}
 
+		target := locations.forAIP(aip).MoveTargetLocationID
 		ssPackage, err := storageClient.Packages.GetByID(ctx, aip.UUID)
 		if err != nil {
 			continue
 		}
-		if strings.Contains(ssPackage.CurrentLocation, a.Locations.MoveTargetLocationID) && ssPackage.Status == "UPLOADED" {
+		if strings.Contains(ssPackage.CurrentLocation, target) && ssPackage.Status == "UPLOADED" {
 			e.AddDetail("AIP already in the desired location")
 			if err := EndEvent(ctx, AIPStatusMoved, a, e, aip); err != nil {
 				return err
@@ -68,7 +251,7 @@ func move(ctx context.Context, logger *slog.Logger, a *App, storageClient *stora
 		if aip.Status == string(AIPStatusMoving) {
 			logger.Info("AIP last know Status: moving")
 		} else {
-			err = storageClient.Packages.Move(ctx, aip.UUID, a.Locations.MoveTargetLocationID)
+			err = storageClient.Packages.Move(ctx, aip.UUID, target)
 			if err != nil {
 				if eventErr := EndEventErr(ctx, a, e, aip, "MOVE operation failed: "+err.Error()); eventErr != nil {
 					return eventErr
@@ -101,7 +284,7 @@ func move(ctx context.Context, logger *slog.Logger, a *App, storageClient *stora
 				if err := a.UpdateAIPStatus(ctx, aip.ID, AIPStatusMoving); err != nil {
 					return err
 				}
-			} else if ssPackage.Status == "UPLOADED" && strings.Contains(ssPackage.CurrentLocation, a.Locations.MoveTargetLocationID) {
+			} else if ssPackage.Status == "UPLOADED" && strings.Contains(ssPackage.CurrentLocation, target) {
 				if err := a.UpdateAIP(ctx, aip.ID, &models.AipSetter{
 					CurrentLocation: omitnull.From(ssPackage.CurrentLocation),
 				}); err != nil {
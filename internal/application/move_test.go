@@ -0,0 +1,296 @@
+package application
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/aarondl/opt/omit"
+	"github.com/stretchr/testify/mock"
+	"go.temporal.io/sdk/activity"
+	"go.temporal.io/sdk/temporal"
+	"go.temporal.io/sdk/testsuite"
+	"go.temporal.io/sdk/workflow"
+	"gotest.tools/v3/assert"
+
+	"github.com/artefactual-labs/migrate/internal/database/gen/models"
+)
+
+func TestPollMoveA(t *testing.T) {
+	t.Parallel()
+
+	const aipUUID = "2faa61dc-ed33-49f4-8b36-954f203bab4a"
+
+	// setup runs moveAIP against a Storage Service that fails the first
+	// failures requests for the package, then reports it stored in the move
+	// target.
+	setup := func(t *testing.T, failures int32) (*App, *testsuite.TestWorkflowEnvironment) {
+		app := newTestApp(t)
+		app.Locations.MoveTargetLocationID = "target-location"
+		_, err := models.Aips.Insert(&models.AipSetter{
+			UUID:   omit.From(aipUUID),
+			Status: omit.From(string(AIPStatusMoving)),
+		}).Exec(t.Context(), app.DB)
+		assert.NilError(t, err)
+
+		var requests atomic.Int32
+		app.StorageClient = newTestStorageService(t, func(w http.ResponseWriter, r *http.Request) {
+			if requests.Add(1) <= failures {
+				http.Error(w, "unavailable", http.StatusServiceUnavailable)
+				return
+			}
+			_ = json.NewEncoder(w).Encode(map[string]string{
+				"uuid":             aipUUID,
+				"status":           "UPLOADED",
+				"current_location": "/api/v2/location/target-location/",
+			})
+		})
+
+		var suite testsuite.WorkflowTestSuite
+		env := suite.NewTestWorkflowEnvironment()
+		env.RegisterWorkflowWithOptions(func(ctx workflow.Context) (string, error) {
+			return moveAIP(ctx, ActivitiesConfig{}, MoveActivityParams{UUID: aipUUID})
+		}, workflow.RegisterOptions{Name: "move-aip"})
+		env.RegisterActivityWithOptions(app.StartMoveA, activity.RegisterOptions{Name: StartMoveActivityName})
+		env.RegisterActivityWithOptions(app.PollMoveA, activity.RegisterOptions{Name: PollMoveActivityName})
+		env.OnActivity(StartMoveActivityName, mock.Anything, mock.Anything).Return(
+			&StartMoveActivityResult{Status: string(AIPStatusMoving), Started: time.Now()}, nil,
+		)
+		return app, env
+	}
+
+	t.Run("Retries a poll that failed", func(t *testing.T) {
+		t.Parallel()
+
+		app, env := setup(t, 2)
+		env.ExecuteWorkflow("move-aip")
+		assert.Assert(t, env.IsWorkflowCompleted())
+		assert.NilError(t, env.GetWorkflowError())
+
+		var status string
+		assert.NilError(t, env.GetWorkflowResult(&status))
+		assert.Equal(t, status, string(AIPStatusMoved))
+
+		aip := getTestAIP(t, app, aipUUID)
+		assert.Equal(t, aip.Status, string(AIPStatusMoved))
+		// The failed polls were not recorded.
+		errs, err := aip.Errors().Count(t.Context(), app.DB)
+		assert.NilError(t, err)
+		assert.Equal(t, errs, int64(0))
+	})
+
+	t.Run("Fails the move when giving up", func(t *testing.T) {
+		t.Parallel()
+
+		app, _ := setup(t, 1)
+		var suite testsuite.WorkflowTestSuite
+		env := suite.NewTestActivityEnvironment()
+		env.RegisterActivityWithOptions(app.PollMoveA, activity.RegisterOptions{Name: PollMoveActivityName})
+
+		_, err := env.ExecuteActivity(PollMoveActivityName, PollMoveActivityParams{UUID: aipUUID, GiveUp: true})
+		var appErr *temporal.ApplicationError
+		assert.Assert(t, errors.As(err, &appErr))
+		assert.Assert(t, appErr.NonRetryable())
+
+		aip := getTestAIP(t, app, aipUUID)
+		assert.Equal(t, aip.Status, string(AIPStatusFailed))
+		events, err := aip.Events().All(t.Context(), app.DB)
+		assert.NilError(t, err)
+		assert.Equal(t, len(events), 1)
+		assert.Equal(t, events[0].Success, false)
+	})
+}
+
+func TestStartMoveA(t *testing.T) {
+	t.Parallel()
+
+	const aipUUID = "5a7c9e1b-3d5f-4a6b-8c0d-2e4f6a8b0c1d"
+
+	for _, tc := range []struct {
+		name       string
+		status     AIPStatus
+		wantResult AIPStatus
+		wantStatus AIPStatus
+	}{
+		{
+			name:       "Re-indexed AIP",
+			status:     AIPStatusIndexed,
+			wantResult: AIPStatusMoved,
+			wantStatus: AIPStatusIndexed,
+		},
+		{
+			name:       "AIP that failed the destination fixity check",
+			status:     AIPStatusDestinationFixityFailed,
+			wantResult: AIPStatusDestinationFixityFailed,
+			wantStatus: AIPStatusDestinationFixityFailed,
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			app := newTestApp(t)
+			_, err := models.Aips.Insert(&models.AipSetter{
+				UUID:   omit.From(aipUUID),
+				Status: omit.From(string(tc.status)),
+				Moved:  omit.From(true),
+			}).Exec(t.Context(), app.DB)
+			assert.NilError(t, err)
+			// A moved AIP is not requested again.
+			app.StorageClient = newTestStorageService(t, func(w http.ResponseWriter, r *http.Request) {
+				t.Errorf("unexpected request: %s %s", r.Method, r.URL.Path)
+				http.NotFound(w, r)
+			})
+
+			var suite testsuite.WorkflowTestSuite
+			env := suite.NewTestActivityEnvironment()
+			env.RegisterActivityWithOptions(app.StartMoveA, activity.RegisterOptions{Name: StartMoveActivityName})
+			val, err := env.ExecuteActivity(StartMoveActivityName, MoveActivityParams{UUID: aipUUID})
+			assert.NilError(t, err)
+
+			var res StartMoveActivityResult
+			assert.NilError(t, val.Get(&res))
+			assert.Equal(t, res.Status, string(tc.wantResult))
+			assert.Equal(t, res.Done, true)
+			assert.Equal(t, getTestAIP(t, app, aipUUID).Status, string(tc.wantStatus))
+		})
+	}
+
+	t.Run("Looks up a moved AIP whose status was reset by a new batch", func(t *testing.T) {
+		t.Parallel()
+
+		for _, tc := range []struct {
+			name       string
+			location   string
+			wantResult AIPStatus
+			wantMoved  bool
+		}{
+			{
+				name:       "Stored in the move target",
+				location:   "target-location",
+				wantResult: AIPStatusMoved,
+				wantMoved:  true,
+			},
+			{
+				name:       "Stored elsewhere",
+				location:   "source-location",
+				wantResult: AIPStatusMoving,
+			},
+		} {
+			t.Run(tc.name, func(t *testing.T) {
+				t.Parallel()
+
+				app := newTestApp(t)
+				app.Config.StorageService.Locations.MoveTargetLocationID = "target-location"
+				_, err := models.Aips.Insert(&models.AipSetter{
+					UUID:   omit.From(aipUUID),
+					Status: omit.From(string(AIPStatusFound)),
+					Moved:  omit.From(true),
+				}).Exec(t.Context(), app.DB)
+				assert.NilError(t, err)
+
+				var moved bool
+				app.StorageClient = newTestStorageService(t, func(w http.ResponseWriter, r *http.Request) {
+					if r.Method == http.MethodPost && r.URL.Path == "/api/v2/file/"+aipUUID+"/move/" {
+						moved = true
+						return
+					}
+					_ = json.NewEncoder(w).Encode(map[string]string{
+						"uuid":             aipUUID,
+						"status":           "UPLOADED",
+						"current_location": "/api/v2/location/" + tc.location + "/",
+					})
+				})
+
+				var suite testsuite.WorkflowTestSuite
+				env := suite.NewTestActivityEnvironment()
+				env.RegisterActivityWithOptions(app.StartMoveA, activity.RegisterOptions{Name: StartMoveActivityName})
+				val, err := env.ExecuteActivity(StartMoveActivityName, MoveActivityParams{UUID: aipUUID})
+				assert.NilError(t, err)
+
+				var res StartMoveActivityResult
+				assert.NilError(t, val.Get(&res))
+				assert.Equal(t, res.Status, string(tc.wantResult))
+				assert.Equal(t, moved, !tc.wantMoved)
+
+				aip := getTestAIP(t, app, aipUUID)
+				assert.Equal(t, aip.Status, string(tc.wantResult))
+				if tc.wantMoved {
+					assert.Equal(t, aip.CurrentLocation.GetOrZero(), "/api/v2/location/target-location/")
+				}
+			})
+		}
+	})
+
+	t.Run("Records the moving status before requesting the move", func(t *testing.T) {
+		t.Parallel()
+
+		app := newTestApp(t)
+		app.Config.StorageService.Locations.MoveTargetLocationID = "target-location"
+		_, err := models.Aips.Insert(&models.AipSetter{
+			UUID:   omit.From(aipUUID),
+			Status: omit.From(string(AIPStatusFound)),
+		}).Exec(t.Context(), app.DB)
+		assert.NilError(t, err)
+
+		var statusAtMove string
+		app.StorageClient = newTestStorageService(t, func(w http.ResponseWriter, r *http.Request) {
+			if r.Method == http.MethodPost && r.URL.Path == "/api/v2/file/"+aipUUID+"/move/" {
+				statusAtMove = getTestAIP(t, app, aipUUID).Status
+				return
+			}
+			_ = json.NewEncoder(w).Encode(map[string]string{
+				"uuid":             aipUUID,
+				"status":           "UPLOADED",
+				"current_location": "/api/v2/location/source-location/",
+			})
+		})
+
+		var suite testsuite.WorkflowTestSuite
+		env := suite.NewTestActivityEnvironment()
+		env.RegisterActivityWithOptions(app.StartMoveA, activity.RegisterOptions{Name: StartMoveActivityName})
+		val, err := env.ExecuteActivity(StartMoveActivityName, MoveActivityParams{UUID: aipUUID})
+		assert.NilError(t, err)
+
+		var res StartMoveActivityResult
+		assert.NilError(t, val.Get(&res))
+		assert.Equal(t, res.Status, string(AIPStatusMoving))
+		assert.Equal(t, statusAtMove, string(AIPStatusMoving))
+	})
+}
+
+func TestLastAttempt(t *testing.T) {
+	t.Parallel()
+
+	for _, tc := range []struct {
+		name string
+		info activity.Info
+		want bool
+	}{
+		{
+			name: "Attempts left",
+			info: activity.Info{Attempt: 2, RetryPolicy: &temporal.RetryPolicy{MaximumAttempts: 3}},
+		},
+		{
+			name: "Last attempt",
+			info: activity.Info{Attempt: 3, RetryPolicy: &temporal.RetryPolicy{MaximumAttempts: 3}},
+			want: true,
+		},
+		{
+			name: "Unlimited attempts",
+			info: activity.Info{Attempt: 10, RetryPolicy: &temporal.RetryPolicy{}},
+		},
+		{
+			name: "Unknown retry policy",
+			info: activity.Info{Attempt: 10},
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			assert.Equal(t, lastAttempt(tc.info), tc.want)
+		})
+	}
+}
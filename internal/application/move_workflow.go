@@ -10,12 +10,16 @@ import (
 
 type MoveWorkflowParams struct {
 	UUID uuid.UUID
+
+	// Settings captured when the workflow was submitted.
+	Settings *WorkflowSettings
 }
 
 type MoveWorkflowResult struct {
 	Message     string
 	MoveDetails []string
 	AIPSize     string
+	Cancelled   bool
 }
 
 const MoveWorkflowName = "move-workflow"
@@ -38,24 +42,52 @@ func (w *MoveWorkflow) Run(ctx workflow.Context, params MoveWorkflowParams) (*Mo
 		},
 	}
 	ctx = workflow.WithActivityOptions(ctx, activityDefaultOptions)
+	control := newWorkflowControl(ctx, false, nil)
+	settings := w.App.workflowSettings(params.Settings)
+	activities := settings.Move.Activities
 
 	var InitResult InitAIPInDatabaseResult
-	err := workflow.ExecuteActivity(ctx, InitAIPInDatabaseName, params.UUID).Get(ctx, &InitResult)
+	err := executeActivity(ctx, activities, InitAIPInDatabaseName, params.UUID, settings).Get(ctx, &InitResult)
 	if err != nil {
 		return nil, err
 	}
-	if InitResult.Status == string(AIPStatusMoved) {
-		result.Message = "AIP already replicated"
+	moved := InitResult.Status == string(AIPStatusMoved) || InitResult.Status == string(AIPStatusIndexed)
+	if !moved && InitResult.Status == string(AIPStatusCleaned) && InitResult.Moved {
+		moved = workflow.GetVersion(ctx, reindexMovedChangeID, workflow.DefaultVersion, 1) != workflow.DefaultVersion
+	}
+	if moved {
+		result.Message = "AIP already moved"
+		// A moved AIP whose re-index failed is re-indexed on a rerun.
+		if !InitResult.ReIndexed {
+			if v := workflow.GetVersion(ctx, reindexMovedChangeID, workflow.DefaultVersion, 1); v != workflow.DefaultVersion {
+				details, err := reindexAIP(ctx, settings, activities, params.UUID.String())
+				if err != nil {
+					return nil, err
+				}
+				result.MoveDetails = append(result.MoveDetails, details...)
+			}
+		}
 		return result, nil
 	}
+	if InitResult.Status == string(AIPStatusUnmappedLocation) {
+		result.Message = "The AIP is not stored in a mapped location"
+		return result, nil
+	}
+	locations := InitResult.locations(settings.Locations)
 
-	err = workflow.ExecuteActivity(ctx, CheckStorageServiceConnectionActivityName, w.App.Locations).Get(ctx, nil)
+	err = executeActivity(ctx, activities, CheckStorageServiceConnectionActivityName, settings.Locations).Get(ctx, nil)
 	if err != nil {
 		return nil, err
 	}
 
+	if cancelled, err := control.checkpoint(ctx); err != nil {
+		return nil, err
+	} else if cancelled {
+		return w.cancel(ctx, params, result)
+	}
+
 	findRes := FindResult{}
-	err = workflow.ExecuteActivity(ctx, FindAName, FindParams{AipID: params.UUID.String()}).Get(ctx, &findRes)
+	err = executeActivity(ctx, activities, FindAName, FindParams{AipID: params.UUID.String()}).Get(ctx, &findRes)
 	if err != nil {
 		return nil, err
 	}
@@ -65,23 +97,149 @@ func (w *MoveWorkflow) Run(ctx workflow.Context, params MoveWorkflowParams) (*Mo
 		return result, nil
 	}
 
-	if w.App.Config.Workflows.Move.CheckFixity {
+	// Fixity checks and moves are heavy on storage, only start them within a
+	// maintenance window.
+	if err := waitForWindow(ctx, settings.Schedule, control, params.UUID.String()); err != nil {
+		return nil, err
+	}
+
+	if cancelled, err := control.checkpoint(ctx); err != nil {
+		return nil, err
+	} else if cancelled {
+		return w.cancel(ctx, params, result)
+	}
+
+	if settings.Move.CheckFixity {
 		fixityParams := FixityActivityParams{UUID: params.UUID.String()}
 		fixityResult := FixityActivityResult{}
-		err = workflow.ExecuteActivity(ctx, FixityActivityName, fixityParams).Get(ctx, &fixityResult)
+		err = executeActivity(ctx, activities, FixityActivityName, fixityParams).Get(ctx, &fixityResult)
 		if err != nil {
 			return nil, err
 		}
 		result.MoveDetails = append(result.MoveDetails, "Fixity status: "+fixityResult.Status)
 	}
 
-	moveParams := MoveActivityParams{UUID: params.UUID.String()}
-	moveResult := MoveActivityResult{}
-	err = workflow.ExecuteActivity(ctx, MoveActivityName, moveParams).Get(ctx, &moveResult)
+	if cancelled, err := control.checkpoint(ctx); err != nil {
+		return nil, err
+	} else if cancelled {
+		return w.cancel(ctx, params, result)
+	}
+
+	leases, err := acquireLocationLeases(ctx, locations, settings.Schedule, params.UUID.String(), locations.SourceLocationID, locations.MoveTargetLocationID)
 	if err != nil {
 		return nil, err
 	}
+	defer leases.release()
+
+	moveParams := MoveActivityParams{UUID: params.UUID.String(), Settings: params.Settings}
+	var status string
+	if v := workflow.GetVersion(ctx, movePollingChangeID, workflow.DefaultVersion, 1); v == workflow.DefaultVersion {
+		moveResult := MoveActivityResult{}
+		err = executeActivity(ctx, activities, MoveActivityName, moveParams).Get(ctx, &moveResult)
+		status = moveResult.Status
+	} else {
+		status, err = moveAIP(ctx, activities, moveParams)
+	}
+	if err != nil {
+		return nil, err
+	}
+	if status == string(AIPStatusMoved) {
+		leases.complete()
+	}
+
+	if status == string(AIPStatusMoved) && settings.Move.VerifyAfterMove {
+		if v := workflow.GetVersion(ctx, verifyAfterMoveChangeID, workflow.DefaultVersion, 1); v != workflow.DefaultVersion {
+			fixityParams := FixityActivityParams{UUID: params.UUID.String()}
+			fixityResult := FixityActivityResult{}
+			err = executeActivity(ctx, activities, DestinationFixityActivityName, fixityParams).Get(ctx, &fixityResult)
+			if err != nil {
+				return nil, err
+			}
+			result.MoveDetails = append(result.MoveDetails, "Destination fixity status: "+fixityResult.Status)
+
+			// Only a move verified at the destination is cleaned up.
+			details, err := cleanupAIP(ctx, settings, params.UUID.String(), false)
+			if err != nil {
+				return nil, err
+			}
+			result.MoveDetails = append(result.MoveDetails, details...)
+		}
+	}
 
-	result.Message = "Status: " + moveResult.Status
+	if status == string(AIPStatusMoved) {
+		details, err := reindexAIP(ctx, settings, activities, params.UUID.String())
+		if err != nil {
+			return nil, err
+		}
+		result.MoveDetails = append(result.MoveDetails, details...)
+	}
+
+	result.Message = "Status: " + status
+	return result, nil
+}
+
+// Polling a move waits movePollInitialWait after the move is requested, then
+// grows the wait by movePollMultiplier on each poll up to movePollMaxWait, and
+// gives up after movePollTimeout.
+const (
+	movePollInitialWait = 500 * time.Millisecond
+	movePollMultiplier  = 1.5
+	movePollMaxWait     = 10 * time.Minute
+	movePollTimeout     = 24 * time.Hour
+)
+
+// moveAIP requests the move and polls the Storage Service until it completes.
+// The waits between polls are durable timers, so a restarted worker carries on
+// polling where the previous one stopped.
+func moveAIP(ctx workflow.Context, activities ActivitiesConfig, params MoveActivityParams) (string, error) {
+	ctx = workflow.WithActivityOptions(ctx, workflow.ActivityOptions{
+		StartToCloseTimeout: 5 * time.Minute,
+		RetryPolicy: &temporal.RetryPolicy{
+			MaximumAttempts: 1,
+		},
+	})
+
+	var started StartMoveActivityResult
+	if err := executeActivity(ctx, activities, StartMoveActivityName, params).Get(ctx, &started); err != nil {
+		return "", err
+	}
+	if started.Done {
+		return started.Status, nil
+	}
+
+	// Polls only read the package status, they can be retried.
+	pollCtx := workflow.WithRetryPolicy(ctx, temporal.RetryPolicy{
+		InitialInterval: time.Second,
+		MaximumAttempts: 3,
+	})
+	pollParams := PollMoveActivityParams{UUID: params.UUID, Started: started.Started, Settings: params.Settings}
+	deadline := workflow.Now(ctx).Add(movePollTimeout)
+	wait := movePollInitialWait
+	for {
+		if err := workflow.Sleep(ctx, wait); err != nil {
+			return "", err
+		}
+		pollParams.GiveUp = !workflow.Now(ctx).Before(deadline)
+
+		var polled PollMoveActivityResult
+		if err := executeActivity(pollCtx, activities, PollMoveActivityName, pollParams).Get(ctx, &polled); err != nil {
+			return "", err
+		}
+		if polled.Done {
+			return polled.Status, nil
+		}
+
+		wait = min(time.Duration(float64(wait)*movePollMultiplier), movePollMaxWait)
+		workflow.GetLogger(ctx).Info("Move in progress, will check again.", "UUID", params.UUID, "wait", wait)
+	}
+}
+
+// cancel records the cancellation of the AIP and stops the workflow.
+func (w *MoveWorkflow) cancel(ctx workflow.Context, params MoveWorkflowParams, result *MoveWorkflowResult) (*MoveWorkflowResult, error) {
+	if err := cancelAIPs(ctx, params.UUID.String()); err != nil {
+		return nil, err
+	}
+	result.Message = "Cancelled"
+	result.Cancelled = true
 	return result, nil
 }
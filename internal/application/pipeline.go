@@ -0,0 +1,108 @@
+package application
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/aarondl/opt/omit"
+	"github.com/aarondl/opt/omitnull"
+	"github.com/stephenafamo/bob/dialect/sqlite/im"
+
+	"github.com/artefactual-labs/migrate/internal/database/gen/models"
+)
+
+type AIPStepStatus string
+
+const (
+	AIPStepStatusInProgress AIPStepStatus = "in-progress"
+	AIPStepStatusDone       AIPStepStatus = "done"
+	AIPStepStatusFailed     AIPStepStatus = "failed"
+	AIPStepStatusCancelled  AIPStepStatus = "cancelled"
+)
+
+type LoadAIPStepsParams struct {
+	UUID string
+}
+
+type LoadAIPStepsResult struct {
+	// Done lists the pipeline steps already completed for the AIP.
+	Done []string
+}
+
+const LoadAIPStepsName = "load-aip-steps"
+
+func (a *App) LoadAIPSteps(ctx context.Context, params LoadAIPStepsParams) (*LoadAIPStepsResult, error) {
+	aip, err := a.GetAIPByID(ctx, params.UUID)
+	if err != nil {
+		return nil, err
+	}
+	steps, err := models.AipSteps.Query(
+		models.SelectWhere.AipSteps.AipID.EQ(aip.ID),
+		models.SelectWhere.AipSteps.Status.EQ(string(AIPStepStatusDone)),
+	).All(ctx, a.DB)
+	if err != nil {
+		return nil, err
+	}
+
+	result := &LoadAIPStepsResult{}
+	for _, s := range steps {
+		result.Done = append(result.Done, s.Step)
+	}
+	return result, nil
+}
+
+type RecordAIPStepParams struct {
+	UUID    string
+	Step    string
+	Status  string
+	Details []string
+}
+
+const RecordAIPStepName = "record-aip-step"
+
+// RecordAIPStep stores the status of a pipeline step. Starting a step resets
+// the outcome of a previous attempt.
+func (a *App) RecordAIPStep(ctx context.Context, params RecordAIPStepParams) error {
+	aip, err := a.GetAIPByID(ctx, params.UUID)
+	if err != nil {
+		return err
+	}
+
+	now := time.Now().Format(time.RFC3339)
+	details := omitnull.FromPtr[string](nil)
+	if len(params.Details) > 0 {
+		details = omitnull.From(strings.Join(params.Details, "\n"))
+	}
+
+	if params.Status == string(AIPStepStatusInProgress) {
+		_, err = models.AipSteps.Insert(
+			&models.AipStepSetter{
+				AipID:       omit.From(aip.ID),
+				Step:        omit.From(params.Step),
+				Status:      omit.From(params.Status),
+				Details:     details,
+				StartedAt:   omit.From(now),
+				CompletedAt: omitnull.FromPtr[string](nil),
+			},
+			im.OnConflict("aip_id", "step").DoUpdate(
+				im.SetExcluded("status", "details", "started_at", "completed_at"),
+			),
+		).Exec(ctx, a.DB)
+	} else {
+		_, err = models.AipSteps.Update(
+			models.AipStepSetter{
+				Status:      omit.From(params.Status),
+				Details:     details,
+				CompletedAt: omitnull.From(now),
+			}.UpdateMod(),
+			models.UpdateWhere.AipSteps.AipID.EQ(aip.ID),
+			models.UpdateWhere.AipSteps.Step.EQ(params.Step),
+		).Exec(ctx, a.DB)
+	}
+	if err != nil {
+		return fmt.Errorf("record %s step: %w", params.Step, err)
+	}
+	return nil
+}
@@ -0,0 +1,307 @@
+package application
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+	"time"
+
+	"github.com/google/uuid"
+	"go.temporal.io/sdk/temporal"
+	"go.temporal.io/sdk/workflow"
+)
+
+type PipelineWorkflowParams struct {
+	UUID uuid.UUID
+
+	// Settings captured when the workflow was submitted.
+	Settings *WorkflowSettings
+}
+
+type PipelineWorkflowResult struct {
+	Message string
+	Details []string
+	AIPSize string
+
+	// Steps completed by this run, and steps skipped because an earlier run
+	// completed them.
+	Completed []string
+	Skipped   []string
+
+	Cancelled bool
+}
+
+const PipelineWorkflowName = "pipeline-workflow"
+
+// PipelineWorkflow runs the steps listed in workflows.pipeline.steps for an
+// AIP, e.g. fixity, move, replicate and verify. The outcome of each step is
+// recorded in the database and a later run skips the steps already done.
+type PipelineWorkflow struct {
+	App *App
+}
+
+func NewPipelineWorkflow(app *App) *PipelineWorkflow {
+	return &PipelineWorkflow{App: app}
+}
+
+// pipelineRun holds the state of a single pipeline workflow execution.
+type pipelineRun struct {
+	settings   *WorkflowSettings
+	activities ActivitiesConfig
+	control    *workflowControl
+	uuid       string
+	init       InitAIPInDatabaseResult
+}
+
+func (w *PipelineWorkflow) Run(ctx workflow.Context, params PipelineWorkflowParams) (*PipelineWorkflowResult, error) {
+	result := &PipelineWorkflowResult{}
+
+	activityDefaultOptions := workflow.ActivityOptions{
+		StartToCloseTimeout: time.Hour * 24 * 365 * 10,
+		RetryPolicy: &temporal.RetryPolicy{
+			MaximumAttempts: 1,
+		},
+	}
+	ctx = workflow.WithActivityOptions(ctx, activityDefaultOptions)
+
+	settings := w.App.workflowSettings(params.Settings)
+	run := &pipelineRun{
+		settings:   settings,
+		activities: settings.Pipeline.Activities,
+		control:    newWorkflowControl(ctx, false, nil),
+		uuid:       params.UUID.String(),
+	}
+
+	err := executeActivity(ctx, run.activities, InitAIPInDatabaseName, params.UUID, run.settings).Get(ctx, &run.init)
+	if err != nil {
+		return nil, err
+	}
+	if run.init.Status == string(AIPStatusFinished) {
+		result.Message = "AIP already finished"
+		return result, nil
+	}
+	if run.init.Status == string(AIPStatusUnmappedLocation) {
+		result.Message = "The AIP is not stored in a mapped location"
+		return result, nil
+	}
+
+	err = executeActivity(ctx, run.activities, CheckStorageServiceConnectionActivityName, settings.Locations).Get(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	if cancelled, err := run.control.checkpoint(ctx); err != nil {
+		return nil, err
+	} else if cancelled {
+		return w.cancel(ctx, run, result)
+	}
+
+	findRes := FindResult{}
+	err = executeActivity(ctx, run.activities, FindAName, FindParams{AipID: run.uuid}).Get(ctx, &findRes)
+	if err != nil {
+		return nil, err
+	}
+	result.AIPSize = findRes.Size
+	if findRes.Status == string(AIPStatusDeleted) {
+		result.Message = "The AIP has been deleted"
+		return result, nil
+	}
+
+	var steps LoadAIPStepsResult
+	err = executeActivity(ctx, run.activities, LoadAIPStepsName, LoadAIPStepsParams{UUID: run.uuid}).Get(ctx, &steps)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, step := range settings.Pipeline.Steps {
+		// A disabled cleanup is not recorded, so that it runs once enabled.
+		if slices.Contains(steps.Done, step) || (step == PipelineStepCleanup && !settings.Cleanup.Enabled) {
+			result.Skipped = append(result.Skipped, step)
+			continue
+		}
+
+		// Verification only reads from the Storage Service, the other
+		// steps are heavy on storage and wait for a maintenance window.
+		if step != PipelineStepVerify && step != PipelineStepCleanup {
+			if err := waitForWindow(ctx, settings.Schedule, run.control, run.uuid); err != nil {
+				return nil, err
+			}
+		}
+		if cancelled, err := run.control.checkpoint(ctx); err != nil {
+			return nil, err
+		} else if cancelled {
+			return w.cancel(ctx, run, result)
+		}
+
+		if err := run.recordStep(ctx, step, AIPStepStatusInProgress, nil); err != nil {
+			return nil, err
+		}
+		details, err := run.runStep(ctx, step)
+		switch {
+		case errors.Is(err, errPipelineCancelled):
+			if err := run.recordStep(ctx, step, AIPStepStatusCancelled, details); err != nil {
+				return nil, err
+			}
+			return w.cancel(ctx, run, result)
+		case err != nil:
+			if recordErr := run.recordStep(ctx, step, AIPStepStatusFailed, append(details, err.Error())); recordErr != nil {
+				return nil, errors.Join(err, recordErr)
+			}
+			return nil, err
+		}
+		if err := run.recordStep(ctx, step, AIPStepStatusDone, details); err != nil {
+			return nil, err
+		}
+		result.Completed = append(result.Completed, step)
+		result.Details = append(result.Details, details...)
+	}
+
+	finished := UpdateAIPStatusParams{UUID: run.uuid, Status: string(AIPStatusFinished)}
+	if err := executeActivity(ctx, run.activities, UpdateAIPStatusName, finished).Get(ctx, nil); err != nil {
+		return nil, err
+	}
+
+	result.Message = "Status: " + string(AIPStatusFinished)
+	return result, nil
+}
+
+// errPipelineCancelled is returned by a step stopped by a cancel signal.
+var errPipelineCancelled = errors.New("pipeline cancelled")
+
+func (r *pipelineRun) runStep(ctx workflow.Context, step string) ([]string, error) {
+	switch step {
+	case PipelineStepFixity:
+		return r.fixity(ctx)
+	case PipelineStepMove:
+		return r.move(ctx)
+	case PipelineStepReplicate:
+		return r.replicate(ctx)
+	case PipelineStepVerify:
+		return r.verify(ctx)
+	case PipelineStepCleanup:
+		// Replicas are verified by the replicate step, an AIP without
+		// replication targets keeps its original.
+		return cleanupAIP(ctx, r.settings, r.uuid, r.hasStep(PipelineStepReplicate) && len(r.init.DesiredReplication) > 0)
+	default:
+		err := fmt.Errorf("unknown pipeline step %q", step)
+		return nil, temporal.NewNonRetryableApplicationError(err.Error(), "InvalidPipelineStep", err)
+	}
+}
+
+func (r *pipelineRun) fixity(ctx workflow.Context) ([]string, error) {
+	var res FixityActivityResult
+	err := executeActivity(ctx, r.activities, FixityActivityName, FixityActivityParams{UUID: r.uuid}).Get(ctx, &res)
+	if err != nil {
+		return nil, err
+	}
+	return []string{"Fixity status: " + res.Status}, nil
+}
+
+func (r *pipelineRun) move(ctx workflow.Context) ([]string, error) {
+	locations := r.locations()
+	leases, err := acquireLocationLeases(ctx, locations, r.settings.Schedule, r.uuid, locations.SourceLocationID, locations.MoveTargetLocationID)
+	if err != nil {
+		return nil, err
+	}
+	defer leases.release()
+
+	status, err := moveAIP(ctx, r.activities, MoveActivityParams{UUID: r.uuid, Settings: r.settings})
+	if err != nil {
+		return nil, err
+	}
+	details := []string{"Move status: " + status}
+	if status != string(AIPStatusMoved) {
+		return details, fmt.Errorf("move ended with status %q", status)
+	}
+	leases.complete()
+
+	reindexed, err := reindexAIP(ctx, r.settings, r.activities, r.uuid)
+	return append(details, reindexed...), err
+}
+
+func (r *pipelineRun) replicate(ctx workflow.Context) ([]string, error) {
+	var details []string
+	for _, repl := range r.init.DesiredReplication {
+		if err := waitForWindow(ctx, r.settings.Schedule, r.control, r.uuid); err != nil {
+			return details, err
+		}
+		if cancelled, err := r.control.checkpoint(ctx); err != nil {
+			return details, err
+		} else if cancelled {
+			return details, errPipelineCancelled
+		}
+
+		params := ReplicateParams{
+			AipID:               r.uuid,
+			LocationUUID:        r.storeLocation(),
+			ReplicaLocationUUID: repl,
+			Settings:            r.settings,
+		}
+		res, err := replicateAIP(ctx, r.locations(), r.settings.Schedule, r.activities, params)
+		if err != nil {
+			return details, err
+		}
+		details = append(details, res.Details...)
+	}
+
+	checked, err := checkReplication(ctx, r.activities, r.uuid)
+	return append(details, checked...), err
+}
+
+func (r *pipelineRun) verify(ctx workflow.Context) ([]string, error) {
+	params := VerifyActivityParams{UUID: r.uuid}
+	if r.hasStep(PipelineStepMove) {
+		params.LocationID = r.locations().MoveTargetLocationID
+	}
+	if r.hasStep(PipelineStepReplicate) {
+		params.Replicas = len(r.init.DesiredReplication)
+	}
+
+	var res VerifyActivityResult
+	if err := executeActivity(ctx, r.activities, VerifyActivityName, params).Get(ctx, &res); err != nil {
+		return nil, err
+	}
+	return res.Details, nil
+}
+
+// storeLocation is the location the AIP is stored in when it is replicated:
+// the move target when the pipeline or an earlier move moved it, the source
+// location otherwise.
+func (r *pipelineRun) storeLocation() string {
+	locations := r.locations()
+	if r.hasStep(PipelineStepMove) && locations.MoveTargetLocationID != "" {
+		return locations.MoveTargetLocationID
+	}
+	return r.init.storeLocation(locations)
+}
+
+// locations returns the locations used for the AIP, those of its mapping
+// when location mappings are configured.
+func (r *pipelineRun) locations() StorageServiceLocationConfig {
+	return r.init.locations(r.settings.Locations)
+}
+
+func (r *pipelineRun) hasStep(step string) bool {
+	return slices.Contains(r.settings.Pipeline.Steps, step)
+}
+
+func (r *pipelineRun) recordStep(ctx workflow.Context, step string, status AIPStepStatus, details []string) error {
+	ctx = workflow.WithActivityOptions(ctx, workflow.ActivityOptions{
+		StartToCloseTimeout: time.Minute,
+		RetryPolicy: &temporal.RetryPolicy{
+			MaximumAttempts: 5,
+		},
+	})
+	params := RecordAIPStepParams{UUID: r.uuid, Step: step, Status: string(status), Details: details}
+	return workflow.ExecuteActivity(ctx, RecordAIPStepName, params).Get(ctx, nil)
+}
+
+// cancel records the cancellation of the AIP and stops the workflow.
+func (w *PipelineWorkflow) cancel(ctx workflow.Context, run *pipelineRun, result *PipelineWorkflowResult) (*PipelineWorkflowResult, error) {
+	if err := cancelAIPs(ctx, run.uuid); err != nil {
+		return nil, err
+	}
+	result.Message = "Cancelled"
+	result.Cancelled = true
+	return result, nil
+}
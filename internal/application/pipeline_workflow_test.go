@@ -0,0 +1,100 @@
+package application
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/mock"
+	"go.temporal.io/sdk/activity"
+	"go.temporal.io/sdk/testsuite"
+	"go.temporal.io/sdk/workflow"
+	"gotest.tools/v3/assert"
+)
+
+func TestPipelineWorkflow(t *testing.T) {
+	t.Parallel()
+
+	aipUUID := uuid.MustParse("6a9f0f33-2d1c-4c4e-9a5a-6c1d2b3e4f50")
+
+	setup := func() (*testsuite.TestWorkflowEnvironment, *[]RecordAIPStepParams) {
+		cfg := DefaultConfig()
+		cfg.Workflows.Pipeline.Steps = []string{PipelineStepFixity, PipelineStepMove, PipelineStepVerify}
+		app := &App{Config: cfg}
+
+		var suite testsuite.WorkflowTestSuite
+		env := suite.NewTestWorkflowEnvironment()
+		env.RegisterWorkflowWithOptions(NewPipelineWorkflow(app).Run, workflow.RegisterOptions{Name: PipelineWorkflowName})
+		for name, fn := range map[string]any{
+			InitAIPInDatabaseName:                     app.InitAIPInDatabase,
+			CheckStorageServiceConnectionActivityName: NewCheckStorageServiceConnectionActivity(nil).Execute,
+			FindAName:             app.FindA,
+			LoadAIPStepsName:      app.LoadAIPSteps,
+			RecordAIPStepName:     app.RecordAIPStep,
+			FixityActivityName:    app.FixityA,
+			StartMoveActivityName: app.StartMoveA,
+			VerifyActivityName:    app.VerifyA,
+			UpdateAIPStatusName:   app.UpdateAIPStatusA,
+		} {
+			env.RegisterActivityWithOptions(fn, activity.RegisterOptions{Name: name})
+		}
+
+		env.OnActivity(InitAIPInDatabaseName, mock.Anything, mock.Anything, mock.Anything).Return(&InitAIPInDatabaseResult{Status: string(AIPStatusNew)}, nil)
+		env.OnActivity(CheckStorageServiceConnectionActivityName, mock.Anything, mock.Anything).Return(nil)
+		env.OnActivity(FindAName, mock.Anything, mock.Anything).Return(&FindResult{Status: string(AIPStatusFound)}, nil)
+		env.OnActivity(LoadAIPStepsName, mock.Anything, mock.Anything).Return(&LoadAIPStepsResult{Done: []string{PipelineStepFixity}}, nil)
+		env.OnActivity(UpdateAIPStatusName, mock.Anything, mock.Anything).Return(&UpdateAIPStatusResult{}, nil)
+
+		var recorded []RecordAIPStepParams
+		env.OnActivity(RecordAIPStepName, mock.Anything, mock.Anything).Return(func(_ context.Context, params RecordAIPStepParams) error {
+			recorded = append(recorded, params)
+			return nil
+		})
+
+		return env, &recorded
+	}
+
+	t.Run("Skips the steps already done", func(t *testing.T) {
+		t.Parallel()
+
+		env, recorded := setup()
+		env.OnActivity(StartMoveActivityName, mock.Anything, mock.Anything).Return(&StartMoveActivityResult{Status: string(AIPStatusMoved), Done: true}, nil)
+		env.OnActivity(VerifyActivityName, mock.Anything, mock.Anything).Return(&VerifyActivityResult{Details: []string{"Verified"}}, nil)
+
+		env.ExecuteWorkflow(PipelineWorkflowName, PipelineWorkflowParams{UUID: aipUUID})
+		assert.Assert(t, env.IsWorkflowCompleted())
+		assert.NilError(t, env.GetWorkflowError())
+
+		var result PipelineWorkflowResult
+		assert.NilError(t, env.GetWorkflowResult(&result))
+		assert.DeepEqual(t, result.Skipped, []string{PipelineStepFixity})
+		assert.DeepEqual(t, result.Completed, []string{PipelineStepMove, PipelineStepVerify})
+		env.AssertActivityNotCalled(t, FixityActivityName, mock.Anything, mock.Anything)
+
+		var statuses []string
+		for _, r := range *recorded {
+			statuses = append(statuses, r.Step+":"+r.Status)
+		}
+		assert.DeepEqual(t, statuses, []string{
+			"move:in-progress", "move:done",
+			"verify:in-progress", "verify:done",
+		})
+	})
+
+	t.Run("Records a failed step", func(t *testing.T) {
+		t.Parallel()
+
+		env, recorded := setup()
+		env.OnActivity(StartMoveActivityName, mock.Anything, mock.Anything).Return(nil, errors.New("storage unavailable"))
+
+		env.ExecuteWorkflow(PipelineWorkflowName, PipelineWorkflowParams{UUID: aipUUID})
+		assert.Assert(t, env.IsWorkflowCompleted())
+		assert.ErrorContains(t, env.GetWorkflowError(), "storage unavailable")
+		env.AssertActivityNotCalled(t, VerifyActivityName, mock.Anything, mock.Anything)
+
+		last := (*recorded)[len(*recorded)-1]
+		assert.Equal(t, last.Step, PipelineStepMove)
+		assert.Equal(t, last.Status, string(AIPStepStatusFailed))
+	})
+}
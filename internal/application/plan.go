@@ -0,0 +1,388 @@
+package application
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"io"
+	"math"
+	"slices"
+	"strings"
+	"text/tabwriter"
+	"time"
+
+	"github.com/aarondl/opt/omit"
+	"github.com/google/uuid"
+	"github.com/stephenafamo/bob"
+
+	"github.com/artefactual-labs/migrate/internal/database/gen/models"
+	"github.com/artefactual-labs/migrate/internal/storage_service"
+)
+
+// PlanClassification is what a run would do with an AIP for a target
+// location.
+type PlanClassification string
+
+const (
+	PlanWillMove            PlanClassification = "will-move"
+	PlanWillReplicate       PlanClassification = "will-replicate"
+	PlanAlreadyInTarget     PlanClassification = "already-in-target"
+	PlanNotFound            PlanClassification = "not-found"
+	PlanDeleted             PlanClassification = "deleted"
+	PlanWrongSourceLocation PlanClassification = "wrong-source-location"
+	PlanUnmappedLocation    PlanClassification = "unmapped-location"
+)
+
+// Plan describes what a move or replicate run would do, computed from the
+// Storage Service without submitting anything to Temporal.
+type Plan struct {
+	// ID is set once the plan is stored.
+	ID        int64
+	Operation BatchOperation
+	CreatedAt time.Time
+	Items     []PlanItem
+}
+
+// PlanItem is the classification of an AIP for one target location: the move
+// target location, or each of the replication targets.
+type PlanItem struct {
+	UUID           string
+	Classification PlanClassification
+	// LocationID is the location the Storage Service reports the AIP in.
+	LocationID string
+	TargetID   string
+	Size       int64
+}
+
+// Plan classifies the AIPs for the operation by looking them up in the
+// Storage Service.
+func (a *App) Plan(ctx context.Context, op BatchOperation, uuids []uuid.UUID) (*Plan, error) {
+	if op != BatchOperationMove && op != BatchOperationReplicate {
+		return nil, fmt.Errorf("unsupported plan operation %q", op)
+	}
+
+	plan := &Plan{Operation: op, CreatedAt: time.Now()}
+	for _, id := range uuids {
+		items, err := a.planAIP(ctx, op, id.String())
+		if err != nil {
+			return nil, fmt.Errorf("plan AIP %s: %w", id, err)
+		}
+		plan.Items = append(plan.Items, items...)
+	}
+	return plan, nil
+}
+
+func (a *App) planAIP(ctx context.Context, op BatchOperation, aipUUID string) ([]PlanItem, error) {
+	pkg, err := a.getPackage(ctx, aipUUID)
+	if err != nil {
+		return nil, err
+	}
+	var location string
+	if pkg != nil {
+		location = storage_service.ResourceUUID(pkg.CurrentLocation)
+	}
+
+	// The locations are resolved like the activities of the submitted
+	// workflow do: with the settings it would capture, the mapping recorded
+	// for the AIP and the targets of the input file.
+	settings := a.workflowSettings(nil)
+	aip, err := a.GetAIPByID(ctx, aipUUID)
+	if err != nil && !errors.Is(err, sql.ErrNoRows) {
+		return nil, fmt.Errorf("get AIP by ID: %w", err)
+	}
+	var recorded []string
+	if aip == nil {
+		aip = &models.Aip{UUID: aipUUID}
+	} else {
+		if err := aip.LoadAipReplications(ctx, a.DB); err != nil {
+			return nil, err
+		}
+		for _, r := range aip.R.AipReplications {
+			recorded = append(recorded, r.LocationUUID.GetOrZero())
+		}
+	}
+
+	// With location mappings, an AIP not mapped yet follows the mapping of
+	// the location it is stored in.
+	resolved, mapped := *aip, true
+	if len(settings.Locations.Mappings) > 0 && aip.SourceLocationUUID == "" {
+		var m LocationMapping
+		if m, mapped = settings.Locations.mapping(location); mapped {
+			resolved.SourceLocationUUID = m.SourceLocationID
+			resolved.MoveTargetLocationUUID = m.MoveTargetLocationID
+		}
+	}
+	locations := settings.Locations.forAIP(&resolved)
+
+	// Replication targets depend on the package when rules are configured.
+	var targets []string
+	switch {
+	case !mapped:
+		targets = []string{""}
+	case op == BatchOperationMove:
+		targets = []string{locations.MoveTargetLocationID}
+	case len(recorded) > 0:
+		targets = recorded
+	default:
+		rts, err := aipReplicationTargets(settings.Locations, &resolved, pkg)
+		if err != nil {
+			return nil, err
+		}
+		for _, t := range rts {
+			targets = append(targets, t.LocationID)
+		}
+	}
+	items := make([]PlanItem, len(targets))
+	for i, target := range targets {
+		items[i] = PlanItem{UUID: aipUUID, TargetID: target}
+	}
+	classify := func(c PlanClassification) []PlanItem {
+		for i := range items {
+			items[i].Classification = c
+		}
+		return items
+	}
+
+	if pkg == nil {
+		return classify(PlanNotFound), nil
+	}
+	for i := range items {
+		items[i].LocationID = location
+		if pkg.Size <= math.MaxInt64 {
+			items[i].Size = int64(pkg.Size)
+		}
+	}
+
+	if strings.EqualFold(pkg.Status, "deleted") {
+		return classify(PlanDeleted), nil
+	}
+	if !mapped {
+		return classify(PlanUnmappedLocation), nil
+	}
+
+	if op == BatchOperationMove {
+		switch location {
+		case locations.MoveTargetLocationID:
+			return classify(PlanAlreadyInTarget), nil
+		case locations.SourceLocationID:
+			return classify(PlanWillMove), nil
+		default:
+			return classify(PlanWrongSourceLocation), nil
+		}
+	}
+
+	// A moved AIP is replicated from its move target location.
+	source := locations.SourceLocationID
+	if aip.Moved && locations.MoveTargetLocationID != "" {
+		source = locations.MoveTargetLocationID
+	}
+	if location != source {
+		return classify(PlanWrongSourceLocation), nil
+	}
+	replicated := make([]string, 0, len(pkg.Replicas))
+	for _, uri := range pkg.Replicas {
+		replica, err := a.StorageClient.Packages.GetByID(ctx, storage_service.ResourceUUID(uri))
+		if errors.Is(err, storage_service.ErrNotFound) {
+			continue
+		} else if err != nil {
+			return nil, err
+		}
+		if !strings.EqualFold(replica.Status, "deleted") {
+			replicated = append(replicated, storage_service.ResourceUUID(replica.CurrentLocation))
+		}
+	}
+	for i := range items {
+		if slices.Contains(replicated, items[i].TargetID) {
+			items[i].Classification = PlanAlreadyInTarget
+		} else {
+			items[i].Classification = PlanWillReplicate
+		}
+	}
+	return items, nil
+}
+
+// pending reports whether the run would transfer the AIP to the target.
+func (i PlanItem) pending() bool {
+	return i.Classification == PlanWillMove || i.Classification == PlanWillReplicate
+}
+
+// AIPs returns the number of AIPs in the plan.
+func (p *Plan) AIPs() int {
+	seen := map[string]struct{}{}
+	for _, item := range p.Items {
+		seen[item.UUID] = struct{}{}
+	}
+	return len(seen)
+}
+
+// TargetBytes returns the bytes the run would transfer to each target
+// location.
+func (p *Plan) TargetBytes() map[string]int64 {
+	bytes := map[string]int64{}
+	for _, item := range p.Items {
+		if item.pending() {
+			bytes[item.TargetID] += item.Size
+		}
+	}
+	return bytes
+}
+
+// TotalBytes returns the bytes the run would transfer to all the target
+// locations.
+func (p *Plan) TotalBytes() int64 {
+	var total int64
+	for _, b := range p.TargetBytes() {
+		total += b
+	}
+	return total
+}
+
+// SavePlan stores the plan and sets its ID.
+func (a *App) SavePlan(ctx context.Context, plan *Plan) error {
+	return a.DB.RunInTx(ctx, nil, func(ctx context.Context, exec bob.Executor) error {
+		p, err := models.Plans.Insert(&models.PlanSetter{
+			Operation:  omit.From(string(plan.Operation)),
+			CreatedAt:  omit.From(plan.CreatedAt.Format(time.RFC3339)),
+			TotalAips:  omit.From(int64(plan.AIPs())),
+			TotalBytes: omit.From(plan.TotalBytes()),
+		}).One(ctx, exec)
+		if err != nil {
+			return fmt.Errorf("insert plan: %w", err)
+		}
+		for _, item := range plan.Items {
+			_, err := models.PlanItems.Insert(&models.PlanItemSetter{
+				PlanID:             omit.From(p.ID),
+				AipUUID:            omit.From(item.UUID),
+				Classification:     omit.From(string(item.Classification)),
+				LocationUUID:       omit.From(item.LocationID),
+				TargetLocationUUID: omit.From(item.TargetID),
+				Size:               omit.From(item.Size),
+			}).Exec(ctx, exec)
+			if err != nil {
+				return fmt.Errorf("insert plan item: %w", err)
+			}
+		}
+		plan.ID = p.ID
+		return nil
+	})
+}
+
+// LoadPlan reads a stored plan.
+func (a *App) LoadPlan(ctx context.Context, id int64) (*Plan, error) {
+	p, err := models.FindPlan(ctx, a.DB, id)
+	if err != nil {
+		return nil, fmt.Errorf("find plan %d: %w", id, err)
+	}
+	items, err := p.PlanItems().All(ctx, a.DB)
+	if err != nil {
+		return nil, fmt.Errorf("list plan items: %w", err)
+	}
+
+	plan := &Plan{ID: p.ID, Operation: BatchOperation(p.Operation)}
+	plan.CreatedAt, err = time.Parse(time.RFC3339, p.CreatedAt)
+	if err != nil {
+		return nil, fmt.Errorf("parse plan creation time: %w", err)
+	}
+	for _, item := range items {
+		plan.Items = append(plan.Items, PlanItem{
+			UUID:           item.AipUUID,
+			Classification: PlanClassification(item.Classification),
+			LocationID:     item.LocationUUID,
+			TargetID:       item.TargetLocationUUID,
+			Size:           item.Size,
+		})
+	}
+	return plan, nil
+}
+
+// CheckPlan compares the stored plan with the given one, computed for the
+// run about to start, and fails listing the differences when they do not
+// match.
+func (a *App) CheckPlan(ctx context.Context, id int64, plan *Plan) error {
+	stored, err := a.LoadPlan(ctx, id)
+	if err != nil {
+		return err
+	}
+	if stored.Operation != plan.Operation {
+		return fmt.Errorf("plan %d is a %s plan, not a %s one", id, stored.Operation, plan.Operation)
+	}
+	if diff := diffPlans(stored, plan); len(diff) > 0 {
+		return fmt.Errorf("plan %d no longer matches:\n%s", id, strings.Join(diff, "\n"))
+	}
+	return nil
+}
+
+// diffPlans lists the items that differ between two plans.
+func diffPlans(old, cur *Plan) []string {
+	type key struct{ uuid, target string }
+	items := make(map[key]PlanItem, len(old.Items))
+	for _, item := range old.Items {
+		items[key{item.UUID, item.TargetID}] = item
+	}
+
+	var diff []string
+	for _, item := range cur.Items {
+		k := key{item.UUID, item.TargetID}
+		prev, ok := items[k]
+		delete(items, k)
+		switch {
+		case !ok:
+			diff = append(diff, fmt.Sprintf("  %s (%s): not planned, now %s", item.UUID, item.TargetID, item.Classification))
+		case prev.Classification != item.Classification:
+			diff = append(diff, fmt.Sprintf("  %s (%s): planned %s, now %s", item.UUID, item.TargetID, prev.Classification, item.Classification))
+		case prev.Size != item.Size:
+			diff = append(diff, fmt.Sprintf("  %s (%s): planned %s, now %s", item.UUID, item.TargetID, formatByteSize(prev.Size), formatByteSize(item.Size)))
+		}
+	}
+	for _, item := range old.Items {
+		if _, ok := items[key{item.UUID, item.TargetID}]; ok {
+			diff = append(diff, fmt.Sprintf("  %s (%s): planned %s, now missing from the input", item.UUID, item.TargetID, item.Classification))
+		}
+	}
+	return diff
+}
+
+// WriteText writes a human readable report of the plan.
+func (p *Plan) WriteText(w io.Writer) error {
+	var b strings.Builder
+
+	if p.ID != 0 {
+		fmt.Fprintf(&b, "Plan %d\t%s\t%s\n\n", p.ID, p.Operation, p.CreatedAt.Format(time.DateTime))
+	} else {
+		fmt.Fprintf(&b, "Plan\t%s\t%s\n\n", p.Operation, p.CreatedAt.Format(time.DateTime))
+	}
+
+	for _, item := range p.Items {
+		fmt.Fprintf(&b, "%s\t%s\t%s\t%s\n", item.UUID, item.Classification, item.TargetID, formatByteSize(item.Size))
+	}
+	b.WriteString("\n")
+
+	counts := map[string]int{}
+	for _, item := range p.Items {
+		counts[string(item.Classification)]++
+	}
+	fmt.Fprintf(&b, "AIPs\t%d\n", p.AIPs())
+	fmt.Fprintf(&b, "AIPs per target\t%d\n", len(p.Items))
+	for _, c := range sortedKeys(counts) {
+		fmt.Fprintf(&b, "  %s\t%d\n", c, counts[c])
+	}
+	b.WriteString("\n")
+
+	targets := p.TargetBytes()
+	keys := make([]string, 0, len(targets))
+	for k := range targets {
+		keys = append(keys, k)
+	}
+	slices.Sort(keys)
+	for _, target := range keys {
+		fmt.Fprintf(&b, "Target %s\t%s\n", target, formatByteSize(targets[target]))
+	}
+	fmt.Fprintf(&b, "Total\t%s\n", formatByteSize(p.TotalBytes()))
+
+	tw := tabwriter.NewWriter(w, 0, 0, 2, ' ', 0)
+	if _, err := io.WriteString(tw, b.String()); err != nil {
+		return err
+	}
+	return tw.Flush()
+}
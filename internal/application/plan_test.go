@@ -0,0 +1,139 @@
+package application
+
+import (
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/aarondl/opt/omit"
+	"github.com/google/uuid"
+	"gotest.tools/v3/assert"
+
+	"github.com/artefactual-labs/migrate/internal/database/gen/models"
+	"github.com/artefactual-labs/migrate/internal/storage_service"
+)
+
+func TestPlan(t *testing.T) {
+	t.Parallel()
+
+	plan := &Plan{
+		Operation: BatchOperationReplicate,
+		Items: []PlanItem{
+			{UUID: "aip-1", Classification: PlanWillReplicate, TargetID: "replica-1", Size: 100},
+			{UUID: "aip-1", Classification: PlanAlreadyInTarget, TargetID: "replica-2", Size: 100},
+			{UUID: "aip-2", Classification: PlanWillReplicate, TargetID: "replica-1", Size: 20},
+			{UUID: "aip-2", Classification: PlanWillReplicate, TargetID: "replica-2", Size: 20},
+			{UUID: "aip-3", Classification: PlanNotFound, TargetID: "replica-1"},
+			{UUID: "aip-3", Classification: PlanNotFound, TargetID: "replica-2"},
+		},
+	}
+
+	t.Run("Counts AIPs and bytes per target", func(t *testing.T) {
+		t.Parallel()
+
+		assert.Equal(t, plan.AIPs(), 3)
+		assert.DeepEqual(t, plan.TargetBytes(), map[string]int64{"replica-1": 120, "replica-2": 20})
+		assert.Equal(t, plan.TotalBytes(), int64(140))
+	})
+
+	t.Run("Lists the differences with a later plan", func(t *testing.T) {
+		t.Parallel()
+
+		assert.Equal(t, len(diffPlans(plan, plan)), 0)
+
+		later := &Plan{
+			Operation: BatchOperationReplicate,
+			Items: []PlanItem{
+				{UUID: "aip-1", Classification: PlanAlreadyInTarget, TargetID: "replica-1", Size: 100},
+				{UUID: "aip-1", Classification: PlanAlreadyInTarget, TargetID: "replica-2", Size: 100},
+				{UUID: "aip-2", Classification: PlanWillReplicate, TargetID: "replica-1", Size: 30},
+				{UUID: "aip-2", Classification: PlanWillReplicate, TargetID: "replica-2", Size: 20},
+				{UUID: "aip-4", Classification: PlanDeleted, TargetID: "replica-1"},
+			},
+		}
+		assert.DeepEqual(t, diffPlans(plan, later), []string{
+			"  aip-1 (replica-1): planned will-replicate, now already-in-target",
+			"  aip-2 (replica-1): planned 20 B, now 30 B",
+			"  aip-4 (replica-1): not planned, now deleted",
+			"  aip-3 (replica-1): planned not-found, now missing from the input",
+			"  aip-3 (replica-2): planned not-found, now missing from the input",
+		})
+	})
+}
+
+func TestPlanAIP(t *testing.T) {
+	t.Parallel()
+
+	const (
+		aipUUID  = "6b8d0f2a-4c6e-4b7d-9e1f-3a5c7e9b1d2f"
+		source   = "1f3a5c7e-9b1d-4f2a-8c4e-6a8b0d2f4e61"
+		target   = "2a4c6e8b-0d2f-4a3b-9d5f-7b9c1e3a5f72"
+		replica1 = "3b5d7f9c-1e3a-4b4c-8e6a-8c0d2f4b6a83"
+		replica2 = "4c6e8a0d-2f4b-4c5d-9f7b-9d1e3a5c7b94"
+	)
+
+	// run plans the operation for the AIP stored in location, recorded in
+	// the database with aip unless nil.
+	run := func(t *testing.T, op BatchOperation, location string, aip *models.AipSetter) []PlanItem {
+		app := newTestApp(t)
+		// The plan follows the settings a submitted workflow would capture,
+		// not the locations the worker started with.
+		app.Config.StorageService.Locations.SourceLocationID = source
+		app.Config.StorageService.Locations.MoveTargetLocationID = target
+		app.Config.StorageService.Locations.ReplicationTargets = []ReplicationTarget{{ID: replica1}}
+		if aip != nil {
+			aip.UUID = omit.From(aipUUID)
+			_, err := models.Aips.Insert(aip).Exec(t.Context(), app.DB)
+			assert.NilError(t, err)
+		}
+		app.StorageClient = newTestStorageService(t, func(w http.ResponseWriter, r *http.Request) {
+			_ = json.NewEncoder(w).Encode(storage_service.Package{
+				UUID:            aipUUID,
+				Status:          "UPLOADED",
+				CurrentLocation: "/api/v2/location/" + location + "/",
+				Size:            10,
+			})
+		})
+
+		plan, err := app.Plan(t.Context(), op, []uuid.UUID{uuid.MustParse(aipUUID)})
+		assert.NilError(t, err)
+		return plan.Items
+	}
+
+	item := func(c PlanClassification, location, target string) PlanItem {
+		return PlanItem{UUID: aipUUID, Classification: c, LocationID: location, TargetID: target, Size: 10}
+	}
+
+	t.Run("Uses the locations of the configuration", func(t *testing.T) {
+		t.Parallel()
+
+		assert.DeepEqual(t, run(t, BatchOperationMove, source, nil), []PlanItem{item(PlanWillMove, source, target)})
+		assert.DeepEqual(t, run(t, BatchOperationReplicate, source, nil), []PlanItem{item(PlanWillReplicate, source, replica1)})
+	})
+
+	t.Run("Uses the targets of the input file", func(t *testing.T) {
+		t.Parallel()
+
+		items := run(t, BatchOperationMove, source, &models.AipSetter{
+			Status:             omit.From(string(AIPStatusNew)),
+			MoveTargetOverride: omit.From(replica2),
+		})
+		assert.DeepEqual(t, items, []PlanItem{item(PlanWillMove, source, replica2)})
+
+		items = run(t, BatchOperationReplicate, source, &models.AipSetter{
+			Status:                     omit.From(string(AIPStatusNew)),
+			ReplicationTargetsOverride: omit.From(`["` + replica2 + `"]`),
+		})
+		assert.DeepEqual(t, items, []PlanItem{item(PlanWillReplicate, source, replica2)})
+	})
+
+	t.Run("Replicates a moved AIP from its move target", func(t *testing.T) {
+		t.Parallel()
+
+		moved := &models.AipSetter{Status: omit.From(string(AIPStatusMoved)), Moved: omit.From(true)}
+		assert.DeepEqual(t, run(t, BatchOperationReplicate, target, moved), []PlanItem{item(PlanWillReplicate, target, replica1)})
+
+		notMoved := &models.AipSetter{Status: omit.From(string(AIPStatusNew))}
+		assert.DeepEqual(t, run(t, BatchOperationReplicate, target, notMoved), []PlanItem{item(PlanWrongSourceLocation, target, replica1)})
+	})
+}
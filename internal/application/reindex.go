@@ -0,0 +1,137 @@
+package application
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"regexp"
+	"strings"
+
+	"go.temporal.io/sdk/activity"
+	"go.temporal.io/sdk/workflow"
+)
+
+const ReindexActivityName = "reindex-aip"
+
+type ReindexActivityParams struct {
+	UUID    string
+	Reindex WorkflowReindexConfig
+
+	// Settings of the workflow, nil for runs submitted before they were
+	// passed to the activity.
+	Settings *WorkflowSettings
+}
+
+type ReindexActivityResult struct {
+	Command string
+	Details []string
+}
+
+// ReindexA runs the re-index management command for the AIP so the search
+// index of Archivematica points at its new location. The command must exit
+// with zero and its output must report the AIP indexed, see
+// parseReindexOutput. The AIP then gets the indexed status, which sets
+// re_indexed.
+func (a *App) ReindexA(ctx context.Context, params ReindexActivityParams) (*ReindexActivityResult, error) {
+	logger := activity.GetLogger(ctx)
+	aip, err := a.GetAIPByID(ctx, params.UUID)
+	if err != nil {
+		return nil, err
+	}
+	result := &ReindexActivityResult{}
+	if aip.ReIndexed {
+		result.Details = append(result.Details, "AIP already re-indexed")
+		return result, nil
+	}
+
+	management := a.management(params.Settings)
+	if params.Reindex.Management != nil {
+		management = *params.Reindex.Management
+	}
+	args := make([]string, len(params.Reindex.Command))
+	for i, arg := range params.Reindex.Command {
+		args[i] = strings.ReplaceAll(arg, "{uuid}", aip.UUID)
+	}
+	cmd, err := management.command(ctx, args...)
+	if err != nil {
+		return nil, err
+	}
+
+	e := StartEvent(ActionIndex)
+	result.Command = cmd.String()
+	logger.Info("Re-indexing AIP", "command", cmd.String())
+
+	stopHeartbeat := heartbeat(ctx)
+	output, err := cmd.CombinedOutput()
+	stopHeartbeat()
+	e.AddDetail(string(output))
+	result.Details = append(result.Details, strings.Split(strings.TrimSpace(string(output)), "\n")...)
+	if err == nil {
+		err = parseReindexOutput(string(output), params.Reindex)
+	}
+	if err != nil {
+		if eventErr := EndEventErrNoFailure(ctx, a, e, aip, "Re-index failed: "+err.Error()); eventErr != nil {
+			return nil, errors.Join(err, eventErr)
+		}
+		return nil, fmt.Errorf("re-index: %w", err)
+	}
+
+	if err := EndEvent(ctx, AIPStatusIndexed, a, e, aip); err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
+
+// parseReindexOutput returns an error when the output of the re-index command
+// matches the failure pattern, or does not match the success pattern.
+func parseReindexOutput(output string, cfg WorkflowReindexConfig) error {
+	failure, err := reindexPattern(cfg.FailurePattern)
+	if err != nil {
+		return err
+	}
+	if failure != nil {
+		if match := failure.FindString(output); match != "" {
+			return fmt.Errorf("the command reported a failure: %s", match)
+		}
+	}
+	success, err := reindexPattern(cfg.SuccessPattern)
+	if err != nil {
+		return err
+	}
+	if success != nil && !success.MatchString(output) {
+		return errors.New("the command did not report the AIP indexed")
+	}
+	return nil
+}
+
+// reindexPattern compiles a pattern of WorkflowReindexConfig, it is nil when
+// the pattern is empty or disabled with "-".
+func reindexPattern(pattern string) (*regexp.Regexp, error) {
+	if pattern == "" || pattern == "-" {
+		return nil, nil
+	}
+	re, err := regexp.Compile(pattern)
+	if err != nil {
+		return nil, fmt.Errorf("invalid pattern %q: %w", pattern, err)
+	}
+	return re, nil
+}
+
+// reindexAIP runs the re-index step when it is enabled and returns its details.
+func reindexAIP(ctx workflow.Context, settings *WorkflowSettings, activities ActivitiesConfig, aipUUID string) ([]string, error) {
+	reindex := settings.Move.Reindex
+	if !reindex.Enabled {
+		return nil, nil
+	}
+	if v := workflow.GetVersion(ctx, reindexChangeID, workflow.DefaultVersion, 1); v == workflow.DefaultVersion {
+		return nil, nil
+	}
+
+	params := ReindexActivityParams{UUID: aipUUID, Reindex: reindex, Settings: settings}
+	var res ReindexActivityResult
+	if err := executeActivity(ctx, activities, ReindexActivityName, params).Get(ctx, &res); err != nil {
+		return nil, err
+	}
+	return append([]string{"Re-indexed with: " + res.Command}, res.Details...), nil
+}
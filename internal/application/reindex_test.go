@@ -0,0 +1,201 @@
+package application
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/aarondl/opt/omit"
+	"github.com/google/uuid"
+	"go.temporal.io/sdk/activity"
+	"go.temporal.io/sdk/testsuite"
+	"go.temporal.io/sdk/workflow"
+	"gotest.tools/v3/assert"
+
+	"github.com/artefactual-labs/migrate/internal/database/gen/models"
+)
+
+func TestReindexA(t *testing.T) {
+	t.Parallel()
+
+	const aipUUID = "3c8e2f1a-6b4d-4e5f-9a7b-1c2d3e4f5a6b"
+
+	// setup returns an activity environment running ReindexA for a moved AIP
+	// with a management command printing output and exiting with code.
+	setup := func(t *testing.T, output string, code int) (*App, *testsuite.TestActivityEnvironment, ReindexActivityParams, func() []string) {
+		app := newTestApp(t)
+		_, err := models.Aips.Insert(&models.AipSetter{
+			UUID:   omit.From(aipUUID),
+			Status: omit.From(string(AIPStatusMoved)),
+			Moved:  omit.From(true),
+		}).Exec(t.Context(), app.DB)
+		assert.NilError(t, err)
+
+		management, args := newTestManagement(t, output, code)
+		params := ReindexActivityParams{
+			UUID: aipUUID,
+			Reindex: WorkflowReindexConfig{
+				Enabled:        true,
+				Management:     &management,
+				Command:        DefaultReindexCommand,
+				SuccessPattern: DefaultReindexSuccessPattern,
+				FailurePattern: DefaultReindexFailurePattern,
+			},
+		}
+
+		var suite testsuite.WorkflowTestSuite
+		env := suite.NewTestActivityEnvironment()
+		env.RegisterActivityWithOptions(app.ReindexA, activity.RegisterOptions{Name: ReindexActivityName})
+		return app, env, params, args
+	}
+
+	t.Run("Sets the indexed status when the command succeeds", func(t *testing.T) {
+		t.Parallel()
+
+		output := "Rebuilding AIP index\nIndexing complete. Indexed 1 AIPs."
+		app, env, params, args := setup(t, output, 0)
+		val, err := env.ExecuteActivity(ReindexActivityName, params)
+		assert.NilError(t, err)
+
+		var res ReindexActivityResult
+		assert.NilError(t, val.Get(&res))
+		assert.DeepEqual(t, res.Details, []string{"Rebuilding AIP index", "Indexing complete. Indexed 1 AIPs."})
+		assert.DeepEqual(t, args(), []string{"rebuild_aip_index_from_storage_service", "--uuid", aipUUID})
+
+		aip := getTestAIP(t, app, aipUUID)
+		assert.Equal(t, aip.Status, string(AIPStatusIndexed))
+		assert.Equal(t, aip.ReIndexed, true)
+		assert.Equal(t, aip.Moved, true)
+
+		// The AIP is not re-indexed twice.
+		val, err = env.ExecuteActivity(ReindexActivityName, params)
+		assert.NilError(t, err)
+		assert.NilError(t, val.Get(&res))
+		assert.DeepEqual(t, res.Details, []string{"AIP already re-indexed"})
+		assert.Equal(t, getTestAIP(t, app, aipUUID).Status, string(AIPStatusIndexed))
+	})
+
+	t.Run("Fails when the command fails", func(t *testing.T) {
+		t.Parallel()
+
+		for _, tc := range []struct {
+			name   string
+			output string
+			code   int
+			err    string
+		}{
+			{
+				name:   "Exit status",
+				output: "Indexing complete. Indexed 1 AIPs.",
+				code:   1,
+				err:    "re-index: exit status 1",
+			},
+			{
+				name:   "Failure reported",
+				output: "CommandError: AIP not found\nIndexing complete. Indexed 1 AIPs.",
+				err:    "re-index: the command reported a failure: CommandError",
+			},
+			{
+				name:   "No AIP indexed",
+				output: "Indexing complete. Indexed 0 AIPs.",
+				err:    "re-index: the command reported a failure: Indexed 0 AIPs",
+			},
+			{
+				name:   "Success not reported",
+				output: "Rebuilding AIP index",
+				err:    "re-index: the command did not report the AIP indexed",
+			},
+		} {
+			t.Run(tc.name, func(t *testing.T) {
+				t.Parallel()
+
+				app, env, params, _ := setup(t, tc.output, tc.code)
+				_, err := env.ExecuteActivity(ReindexActivityName, params)
+				assert.ErrorContains(t, err, tc.err)
+
+				aip := getTestAIP(t, app, aipUUID)
+				assert.Equal(t, aip.Status, string(AIPStatusMoved))
+				assert.Equal(t, aip.ReIndexed, false)
+			})
+		}
+	})
+
+	t.Run("Only checks the exit status when the patterns are disabled", func(t *testing.T) {
+		t.Parallel()
+
+		app, env, params, _ := setup(t, "Rebuilding AIP index", 0)
+		params.Reindex.SuccessPattern, params.Reindex.FailurePattern = "-", "-"
+		_, err := env.ExecuteActivity(ReindexActivityName, params)
+		assert.NilError(t, err)
+		assert.Equal(t, getTestAIP(t, app, aipUUID).Status, string(AIPStatusIndexed))
+	})
+}
+
+func TestMoveWorkflowReindex(t *testing.T) {
+	t.Parallel()
+
+	aipUUID := uuid.MustParse("5a7c9e1b-3d5f-4a6b-8c0d-2e4f6a8b0c1d")
+
+	// run runs the move workflow for an AIP in status, re-indexed or not, and
+	// returns its result and whether the re-index command ran.
+	run := func(t *testing.T, status AIPStatus, reindexed bool) (*MoveWorkflowResult, bool) {
+		app := newTestApp(t)
+		_, err := models.Aips.Insert(&models.AipSetter{
+			UUID:      omit.From(aipUUID.String()),
+			Status:    omit.From(string(status)),
+			Moved:     omit.From(true),
+			ReIndexed: omit.From(reindexed),
+		}).Exec(t.Context(), app.DB)
+		assert.NilError(t, err)
+
+		management, _ := newTestManagement(t, "Indexing complete. Indexed 1 AIPs.", 0)
+		app.Config.Workflows.Move.Reindex = WorkflowReindexConfig{
+			Enabled:        true,
+			Management:     &management,
+			Command:        DefaultReindexCommand,
+			SuccessPattern: DefaultReindexSuccessPattern,
+			FailurePattern: DefaultReindexFailurePattern,
+		}
+
+		var suite testsuite.WorkflowTestSuite
+		env := suite.NewTestWorkflowEnvironment()
+		env.RegisterWorkflowWithOptions(NewMoveWorkflow(app).Run, workflow.RegisterOptions{Name: MoveWorkflowName})
+		env.RegisterActivityWithOptions(app.InitAIPInDatabase, activity.RegisterOptions{Name: InitAIPInDatabaseName})
+		env.RegisterActivityWithOptions(app.ReindexA, activity.RegisterOptions{Name: ReindexActivityName})
+		env.ExecuteWorkflow(MoveWorkflowName, MoveWorkflowParams{UUID: aipUUID})
+		assert.Assert(t, env.IsWorkflowCompleted())
+		assert.NilError(t, env.GetWorkflowError())
+
+		var result MoveWorkflowResult
+		assert.NilError(t, env.GetWorkflowResult(&result))
+		// The script of newTestManagement writes its arguments when it runs.
+		_, err = os.Stat(filepath.Join(filepath.Dir(management.Host.ManagePath), "args"))
+		return &result, err == nil
+	}
+
+	t.Run("Re-indexes a moved AIP not re-indexed yet", func(t *testing.T) {
+		t.Parallel()
+
+		result, ran := run(t, AIPStatusMoved, false)
+		assert.Equal(t, result.Message, "AIP already moved")
+		assert.Assert(t, ran)
+		assert.Equal(t, result.MoveDetails[len(result.MoveDetails)-1], "Indexing complete. Indexed 1 AIPs.")
+	})
+
+	t.Run("Re-indexes a cleaned up AIP not re-indexed yet", func(t *testing.T) {
+		t.Parallel()
+
+		result, ran := run(t, AIPStatusCleaned, false)
+		assert.Equal(t, result.Message, "AIP already moved")
+		assert.Assert(t, ran)
+	})
+
+	t.Run("Skips a re-indexed AIP", func(t *testing.T) {
+		t.Parallel()
+
+		result, ran := run(t, AIPStatusIndexed, true)
+		assert.Equal(t, result.Message, "AIP already moved")
+		assert.Assert(t, !ran)
+		assert.Equal(t, len(result.MoveDetails), 0)
+	})
+}
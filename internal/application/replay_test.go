@@ -0,0 +1,62 @@
+package application
+
+import (
+	"log/slog"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"go.temporal.io/sdk/log"
+	"go.temporal.io/sdk/worker"
+	"go.temporal.io/sdk/workflow"
+	"gotest.tools/v3/assert"
+)
+
+// TestReplayHistories replays the workflow histories recorded by earlier
+// releases, which fails if a change to the workflow code is not guarded by
+// workflow.GetVersion. Record a new history for every new version with:
+//
+//	temporal workflow show --workflow-id WORKFLOW_ID --output json
+func TestReplayHistories(t *testing.T) {
+	t.Parallel()
+
+	// Runs submitted before the settings were passed in the parameters use
+	// the configuration of the worker. Limits, a window that is closed at the
+	// time of the recorded runs and the optional steps must not change how
+	// they replay.
+	cfg := DefaultConfig()
+	cfg.StorageService.Locations = StorageServiceLocationConfig{
+		SourceLocationID:     "f4b0cf4e-54f5-4f4c-b4f0-4ea2b6a4c2a1",
+		MoveTargetLocationID: "0a8bfbd8-2c0f-4b5c-a1e6-7d6f8b1e8c35",
+		ReplicationTargets:   []ReplicationTarget{{ID: "5e2d4cf2-e0a5-4f49-9d8a-3c6a7b5f1b72", Name: "Replica Location 1"}},
+		Limits: []LocationLimit{
+			{LocationID: "0a8bfbd8-2c0f-4b5c-a1e6-7d6f8b1e8c35", MaxConcurrent: 1},
+			{LocationID: "5e2d4cf2-e0a5-4f49-9d8a-3c6a7b5f1b72", MaxConcurrent: 1},
+		},
+	}
+	cfg.Schedule = ScheduleConfig{
+		Timezone: "UTC",
+		Windows:  []ScheduleWindow{{Start: "0 2 * * *", Duration: Duration(time.Hour)}},
+	}
+	cfg.Workflows.Move.VerifyAfterMove = true
+	cfg.Workflows.Cleanup.Enabled = true
+	cfg.Workflows.Move.Reindex.Enabled = true
+	app := &App{Config: cfg, Locations: cfg.StorageService.Locations}
+
+	replayer := worker.NewWorkflowReplayer()
+	replayer.RegisterWorkflowWithOptions(NewMoveWorkflow(app).Run, workflow.RegisterOptions{Name: MoveWorkflowName})
+	replayer.RegisterWorkflowWithOptions(NewReplicateWorkflow(app).Run, workflow.RegisterOptions{Name: ReplicateWorkflowName})
+	replayer.RegisterWorkflowWithOptions(NewPipelineWorkflow(app).Run, workflow.RegisterOptions{Name: PipelineWorkflowName})
+	replayer.RegisterWorkflowWithOptions(NewBatchWorkflow(app).Run, workflow.RegisterOptions{Name: BatchWorkflowName})
+
+	paths, err := filepath.Glob(filepath.Join("testdata", "histories", "*.json"))
+	assert.NilError(t, err)
+	assert.Assert(t, len(paths) > 0)
+
+	logger := log.NewStructuredLogger(slog.New(slog.DiscardHandler))
+	for _, path := range paths {
+		t.Run(filepath.Base(path), func(t *testing.T) {
+			assert.NilError(t, replayer.ReplayWorkflowHistoryFromJSONFile(logger, path))
+		})
+	}
+}
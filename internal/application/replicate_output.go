@@ -0,0 +1,83 @@
+package application
+
+import (
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+)
+
+// ReplicationOutcome is the result of a create_aip_replicas run.
+type ReplicationOutcome string
+
+const (
+	// ReplicationCreated means replicas were created.
+	ReplicationCreated ReplicationOutcome = "created"
+	// ReplicationNoneCreated means the command ran but created no replica.
+	ReplicationNoneCreated ReplicationOutcome = "none-created"
+	// ReplicationNoAIPs means the AIP is not in the source location, e.g.
+	// because it has been deleted.
+	ReplicationNoAIPs ReplicationOutcome = "no-aips"
+	// ReplicationError means the command reported an error.
+	ReplicationError ReplicationOutcome = "error"
+	// ReplicationUnknown means the output could not be understood.
+	ReplicationUnknown ReplicationOutcome = "unknown"
+)
+
+// ReplicationOutput is the parsed output of create_aip_replicas.
+type ReplicationOutput struct {
+	Outcome ReplicationOutcome
+
+	// Created and Total are the counts of the summary line, e.g. "New
+	// replicas created for 1 of 1 AIPs in location ...".
+	Created int
+	Total   int
+
+	// Message is the line the outcome was read from, empty when unknown.
+	Message string
+
+	// ReplicaUUIDs lists the replicas the command reported creating.
+	ReplicaUUIDs []string
+}
+
+var (
+	replicasCreatedRegexp = regexp.MustCompile(`New replicas created for (\d+) of (\d+) AIPs`)
+	noAIPsRegexp          = regexp.MustCompile(`No AIPs to replicate`)
+	commandErrorRegexp    = regexp.MustCompile(`CommandError:`)
+	replicaUUIDRegexp     = regexp.MustCompile(`(?i)\breplica (?:package )?([0-9a-f]{8}-[0-9a-f]{4}-[0-9a-f]{4}-[0-9a-f]{4}-[0-9a-f]{12})\b`)
+)
+
+// parseReplicationOutput reads the outcome of create_aip_replicas from its
+// output. It never fails: output it does not understand is
+// ReplicationUnknown.
+func parseReplicationOutput(output string) ReplicationOutput {
+	res := ReplicationOutput{Outcome: ReplicationUnknown}
+	var commandError string
+	for line := range strings.Lines(output) {
+		line = strings.TrimSpace(line)
+		if m := replicaUUIDRegexp.FindStringSubmatch(line); m != nil {
+			id := strings.ToLower(m[1])
+			if !slices.Contains(res.ReplicaUUIDs, id) {
+				res.ReplicaUUIDs = append(res.ReplicaUUIDs, id)
+			}
+		}
+		switch {
+		case noAIPsRegexp.MatchString(line):
+			res.Outcome, res.Message = ReplicationNoAIPs, line
+		case res.Outcome != ReplicationNoAIPs && replicasCreatedRegexp.MatchString(line):
+			m := replicasCreatedRegexp.FindStringSubmatch(line)
+			res.Created, _ = strconv.Atoi(m[1])
+			res.Total, _ = strconv.Atoi(m[2])
+			res.Outcome, res.Message = ReplicationNoneCreated, line
+			if res.Created > 0 {
+				res.Outcome = ReplicationCreated
+			}
+		case commandError == "" && commandErrorRegexp.MatchString(line):
+			commandError = line
+		}
+	}
+	if res.Outcome == ReplicationUnknown && commandError != "" {
+		res.Outcome, res.Message = ReplicationError, commandError
+	}
+	return res
+}
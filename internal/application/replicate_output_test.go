@@ -0,0 +1,72 @@
+package application
+
+import (
+	"testing"
+
+	"gotest.tools/v3/assert"
+)
+
+func TestParseReplicationOutput(t *testing.T) {
+	t.Parallel()
+
+	for _, tc := range []struct {
+		name   string
+		output string
+		want   ReplicationOutput
+	}{
+		{
+			name: "Created",
+			output: `INFO: Starting replication for 2faa61dc-ed33-49f4-8b36-954f203bab4a
+INFO: Replica 2faa61dc-ed33-49f4-8b36-954f203bab4b created in location 71cb2196-5629-4225-aaf7-d8431b0895c4
+New replicas created for 1 of 1 AIPs in location 71cb2196-5629-4225-aaf7-d8431b0895c4
+`,
+			want: ReplicationOutput{
+				Outcome:      ReplicationCreated,
+				Created:      1,
+				Total:        1,
+				Message:      "New replicas created for 1 of 1 AIPs in location 71cb2196-5629-4225-aaf7-d8431b0895c4",
+				ReplicaUUIDs: []string{"2faa61dc-ed33-49f4-8b36-954f203bab4b"},
+			},
+		},
+		{
+			name:   "None created",
+			output: "New replicas created for 0 of 1 AIPs in location 71cb2196-5629-4225-aaf7-d8431b0895c4.\n",
+			want: ReplicationOutput{
+				Outcome: ReplicationNoneCreated,
+				Total:   1,
+				Message: "New replicas created for 0 of 1 AIPs in location 71cb2196-5629-4225-aaf7-d8431b0895c4.",
+			},
+		},
+		{
+			name:   "No AIPs",
+			output: "CommandError: No AIPs to replicate in location 72a9c518-2747-4cb5-aeba-e6309d946e79\n",
+			want: ReplicationOutput{
+				Outcome: ReplicationNoAIPs,
+				Message: "CommandError: No AIPs to replicate in location 72a9c518-2747-4cb5-aeba-e6309d946e79",
+			},
+		},
+		{
+			name:   "Error",
+			output: "Traceback (most recent call last):\nCommandError: Replicator location does not exist\n",
+			want: ReplicationOutput{
+				Outcome: ReplicationError,
+				Message: "CommandError: Replicator location does not exist",
+			},
+		},
+		{
+			name:   "Single line",
+			output: "done",
+			want:   ReplicationOutput{Outcome: ReplicationUnknown},
+		},
+		{
+			name: "Empty",
+			want: ReplicationOutput{Outcome: ReplicationUnknown},
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			assert.DeepEqual(t, parseReplicationOutput(tc.output), tc.want)
+		})
+	}
+}
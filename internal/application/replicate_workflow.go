@@ -7,8 +7,6 @@ import (
 	"fmt"
 	"log/slog"
 	"math"
-	"os/exec"
-	"sort"
 	"strings"
 	"time"
 
@@ -26,11 +24,15 @@ import (
 
 type ReplicateWorkflowParams struct {
 	UUID uuid.UUID
+
+	// Settings captured when the workflow was submitted.
+	Settings *WorkflowSettings
 }
 type ReplicateWorkflowResult struct {
 	Message          string
 	ReplicateDetails []string
 	AIPSize          string
+	Cancelled        bool
 }
 
 const ReplicateWorkflowName = "replicate-workflow"
@@ -53,25 +55,40 @@ func (w *ReplicateWorkflow) Run(ctx workflow.Context, params ReplicateWorkflowPa
 		},
 	}
 	ctx = workflow.WithActivityOptions(ctx, activityDefaultOptions)
+	control := newWorkflowControl(ctx, false, nil)
+	settings := w.App.workflowSettings(params.Settings)
+	activities := settings.Replicate.Activities
 
 	var InitResult InitAIPInDatabaseResult
-	err := workflow.ExecuteActivity(ctx, InitAIPInDatabaseName, params.UUID).Get(ctx, &InitResult)
+	err := executeActivity(ctx, activities, InitAIPInDatabaseName, params.UUID, settings).Get(ctx, &InitResult)
 	if err != nil {
 		return nil, err
 	}
 
-	if InitResult.Status == string(AIPStatusReplicated) {
+	// A replicated AIP can have been cleaned up since.
+	if InitResult.Status == string(AIPStatusReplicated) || (InitResult.Status == string(AIPStatusCleaned) && InitResult.Replicated) {
 		result.Message = "AIP already replicated"
 		return result, nil
 	}
+	if InitResult.Status == string(AIPStatusUnmappedLocation) {
+		result.Message = "The AIP is not stored in a mapped location"
+		return result, nil
+	}
+	locations := InitResult.locations(settings.Locations)
 
-	err = workflow.ExecuteActivity(ctx, CheckStorageServiceConnectionActivityName, w.App.Locations).Get(ctx, nil)
+	err = executeActivity(ctx, activities, CheckStorageServiceConnectionActivityName, settings.Locations).Get(ctx, nil)
 	if err != nil {
 		return nil, err
 	}
 
+	if cancelled, err := control.checkpoint(ctx); err != nil {
+		return nil, err
+	} else if cancelled {
+		return w.cancel(ctx, params, result)
+	}
+
 	findRes := FindResult{}
-	err = workflow.ExecuteActivity(ctx, FindAName, FindParams{AipID: params.UUID.String()}).Get(ctx, &findRes)
+	err = executeActivity(ctx, activities, FindAName, FindParams{AipID: params.UUID.String()}).Get(ctx, &findRes)
 	if err != nil {
 		return nil, err
 	}
@@ -82,13 +99,22 @@ func (w *ReplicateWorkflow) Run(ctx workflow.Context, params ReplicateWorkflowPa
 	}
 
 	for _, repl := range InitResult.DesiredReplication {
-		var replicateResult ReplicateResult
+		if err := waitForWindow(ctx, settings.Schedule, control, params.UUID.String()); err != nil {
+			return nil, err
+		}
+		if cancelled, err := control.checkpoint(ctx); err != nil {
+			return nil, err
+		} else if cancelled {
+			return w.cancel(ctx, params, result)
+		}
+
 		replicateParams := ReplicateParams{
 			AipID:               params.UUID.String(),
-			LocationUUID:        w.App.Locations.SourceLocationID,
+			LocationUUID:        InitResult.storeLocation(locations),
 			ReplicaLocationUUID: repl,
+			Settings:            params.Settings,
 		}
-		err = workflow.ExecuteActivity(ctx, ReplicateAName, replicateParams).Get(ctx, &replicateResult)
+		replicateResult, err := replicateAIP(ctx, locations, settings.Schedule, activities, replicateParams)
 		if err != nil {
 			return nil, err
 		}
@@ -96,21 +122,118 @@ func (w *ReplicateWorkflow) Run(ctx workflow.Context, params ReplicateWorkflowPa
 	}
 
 	// TODO(daniel): Implement AIP Status reconciliation based on the workflow.
-	err = workflow.ExecuteActivity(ctx, CheckReplicationStatusName, CheckReplicationStatusParams{AIP_UUID: params.UUID.String()}).Get(ctx, nil)
+	details, err := checkReplication(ctx, activities, params.UUID.String())
+	if err != nil {
+		return nil, err
+	}
+	result.ReplicateDetails = append(result.ReplicateDetails, details...)
+
+	// An AIP without replication targets is reported replicated, it keeps
+	// its original.
+	details, err = cleanupAIP(ctx, settings, params.UUID.String(), len(InitResult.DesiredReplication) > 0)
 	if err != nil {
 		return nil, err
 	}
+	result.ReplicateDetails = append(result.ReplicateDetails, details...)
+	return result, nil
+}
+
+// cancel records the cancellation of the AIP and stops the workflow.
+func (w *ReplicateWorkflow) cancel(ctx workflow.Context, params ReplicateWorkflowParams, result *ReplicateWorkflowResult) (*ReplicateWorkflowResult, error) {
+	if err := cancelAIPs(ctx, params.UUID.String()); err != nil {
+		return nil, err
+	}
+	result.Message = "Cancelled"
+	result.Cancelled = true
 	return result, nil
 }
 
+// replicateAIP runs ReplicateA while holding leases on the source and replica
+// locations.
+func replicateAIP(ctx workflow.Context, locations StorageServiceLocationConfig, schedule ScheduleConfig, activities ActivitiesConfig, params ReplicateParams) (*ReplicateResult, error) {
+	leases, err := acquireLocationLeases(ctx, locations, schedule, params.AipID, params.LocationUUID, params.ReplicaLocationUUID)
+	if err != nil {
+		return nil, err
+	}
+	defer leases.release()
+
+	var result ReplicateResult
+	if err := executeActivity(ctx, activities, ReplicateAName, params).Get(ctx, &result); err != nil {
+		return nil, err
+	}
+	// An existing replica was not transferred, it uses no daily budget.
+	if !result.Existing {
+		leases.complete()
+	}
+	return &result, nil
+}
+
+// checkReplication verifies the fixity of the replicas of the AIP and marks it
+// as replicated once all of them are created and intact.
+func checkReplication(ctx workflow.Context, activities ActivitiesConfig, aipUUID string) ([]string, error) {
+	params := CheckReplicationStatusParams{AIP_UUID: aipUUID}
+	var details []string
+	if v := workflow.GetVersion(ctx, replicaFixityChangeID, workflow.DefaultVersion, 1); v != workflow.DefaultVersion {
+		var res VerifyReplicasActivityResult
+		err := executeActivity(ctx, activities, VerifyReplicasActivityName, VerifyReplicasActivityParams{UUID: aipUUID}).Get(ctx, &res)
+		if err != nil {
+			return nil, err
+		}
+		details = res.Details
+		params.RequireFixity = true
+	}
+
+	if err := executeActivity(ctx, activities, CheckReplicationStatusName, params).Get(ctx, nil); err != nil {
+		return details, err
+	}
+	return details, nil
+}
+
 const InitAIPInDatabaseName = "init_AIP_in_database"
 
 type InitAIPInDatabaseResult struct {
 	Status             string
 	DesiredReplication []string
+
+	// SourceLocationID and MoveTargetLocationID are the locations of the
+	// mapping chosen for the AIP, or the move target of the input file.
+	// They are empty when the configured locations apply.
+	SourceLocationID     string
+	MoveTargetLocationID string
+
+	// Moved is set once the AIP has been moved to its move target location,
+	// Replicated once all of its replicas have been verified and ReIndexed
+	// once the search index points at the moved AIP.
+	Moved      bool
+	Replicated bool
+	ReIndexed  bool
 }
 
-func (a *App) InitAIPInDatabase(ctx context.Context, id uuid.UUID) (*InitAIPInDatabaseResult, error) {
+// storeLocation returns the location the AIP is stored in.
+func (r InitAIPInDatabaseResult) storeLocation(l StorageServiceLocationConfig) string {
+	if r.Moved && l.MoveTargetLocationID != "" {
+		return l.MoveTargetLocationID
+	}
+	return l.SourceLocationID
+}
+
+// locations returns the locations used for the AIP.
+func (r InitAIPInDatabaseResult) locations(l StorageServiceLocationConfig) StorageServiceLocationConfig {
+	if r.SourceLocationID != "" {
+		l.SourceLocationID = r.SourceLocationID
+	}
+	if r.MoveTargetLocationID != "" {
+		l.MoveTargetLocationID = r.MoveTargetLocationID
+	}
+	return l
+}
+
+// InitAIPInDatabase records the AIP and, on its first run, its location mapping
+// and replication targets, chosen with the locations of the settings. Runs
+// submitted before the settings were passed use the configuration of the
+// worker.
+func (a *App) InitAIPInDatabase(ctx context.Context, id uuid.UUID, settings *WorkflowSettings) (*InitAIPInDatabaseResult, error) {
+	locations := a.workflowSettings(settings).Locations
 	result := &InitAIPInDatabaseResult{}
 	aipSetter := &models.AipSetter{
 		UUID:   omit.From(id.String()),
@@ -134,12 +257,40 @@ func (a *App) InitAIPInDatabase(ctx context.Context, id uuid.UUID) (*InitAIPInDa
 	if err := aip.LoadAipReplications(ctx, a.DB); err != nil {
 		return nil, err
 	}
-	if aip.Status == string(AIPStatusNew) && len(aip.R.AipReplications) == 0 {
-		for _, l := range a.Locations.ReplicationTargets {
+
+	// The package is only looked up when the mapping or the replication
+	// targets of the AIP depend on it.
+	mapping := len(locations.Mappings) > 0 && (aip.SourceLocationUUID == "" || aip.Status == string(AIPStatusUnmappedLocation))
+	targets := len(aip.R.AipReplications) == 0 && len(locations.ReplicationRules) > 0 && aip.ReplicationTargetsOverride == ""
+	var pkg *storage_service.Package
+	if mapping || targets {
+		if pkg, err = a.getPackage(ctx, aip.UUID); err != nil {
+			return nil, err
+		}
+	}
+	if mapping && pkg != nil {
+		if mapped, err := a.mapAIP(ctx, locations, aip, pkg); err != nil {
+			return nil, err
+		} else if !mapped {
+			result.Status = aip.Status
+			return result, nil
+		}
+	}
+
+	// AIPs not found have no mapping, FindA gives them their status.
+	resumable := aip.Status == string(AIPStatusNew) || aip.Status == string(AIPStatusCancelled)
+	unmapped := len(locations.Mappings) > 0 && aip.SourceLocationUUID == ""
+	if resumable && !unmapped && len(aip.R.AipReplications) == 0 {
+		targets, err := aipReplicationTargets(locations, aip, pkg)
+		if err != nil {
+			return nil, err
+		}
+		for _, t := range targets {
 			replicationLocationSetter := models.AipReplicationSetter{
 				AipID:        omit.From(aip.ID),
-				LocationUUID: omitnull.From(l.ID),
+				LocationUUID: omitnull.From(t.LocationID),
 				Status:       omit.From(string(AIPReplicationStatusNew)),
+				Rule:         omit.From(t.Rule),
 			}
 			if err := aip.InsertAipReplications(ctx, a.DB, &replicationLocationSetter); err != nil {
 				return nil, err
@@ -153,6 +304,14 @@ func (a *App) InitAIPInDatabase(ctx context.Context, id uuid.UUID) (*InitAIPInDa
 		result.DesiredReplication = append(result.DesiredReplication, rl.LocationUUID.GetOrZero())
 	}
 	result.Status = aip.Status
+	result.SourceLocationID = aip.SourceLocationUUID
+	result.MoveTargetLocationID = aip.MoveTargetLocationUUID
+	result.Moved = aip.Moved
+	result.Replicated = aip.Replicated
+	result.ReIndexed = aip.ReIndexed
+	if aip.MoveTargetOverride != "" {
+		result.MoveTargetLocationID = aip.MoveTargetOverride
+	}
 	return result, nil
 }
 
@@ -160,11 +319,19 @@ type ReplicateParams struct {
 	AipID               string
 	LocationUUID        string
 	ReplicaLocationUUID string
+
+	// Settings of the workflow, nil for runs submitted before they were
+	// passed to the activity.
+	Settings *WorkflowSettings
 }
 type ReplicateResult struct {
-	Command string
-	Details []string
-	Status  string
+	Command     string
+	Details     []string
+	Status      string
+	ReplicaUUID string
+	// Existing is set when the target already held a replica, so nothing
+	// was copied.
+	Existing bool
 }
 
 const ReplicateAName = "Replicate-aip"
@@ -197,58 +364,23 @@ func (a *App) ReplicateA(ctx context.Context, params ReplicateParams) (*Replicat
 	e.AddDetail(d1)
 	result.Details = append(result.Details, d1)
 
-	var cmd *exec.Cmd
-	management := a.Config.StorageService.Management
-	switch management.Mode {
-	case "docker":
-		container := management.Docker.Container
-		if container == "" {
-			return nil, fmt.Errorf("storage_service.management.docker.container is required")
-		}
-		managePath := management.Docker.ManagePath
-		if managePath == "" {
-			return nil, fmt.Errorf("storage_service.management.docker.manage_path is required")
-		}
-		cmd = exec.Command(
-			"docker",
-			"exec",
-			container,
-			managePath,
-			"create_aip_replicas",
-			"--aip-uuid", aip.UUID,
-			"--aip-store-location", params.LocationUUID,
-			"--replicator-location", params.ReplicaLocationUUID,
-		)
-	case "host":
-		managePath := management.Host.ManagePath
-		if managePath == "" {
-			return nil, fmt.Errorf("storage_service.management.host.manage_path is required")
-		}
-		pythonPath := management.Host.PythonPath
-		if pythonPath == "" {
-			pythonPath = "python3"
-		}
-		cmd = exec.Command(
-			pythonPath,
-			managePath,
-			"create_aip_replicas",
-			"--aip-uuid", aip.UUID,
-			"--aip-store-location", params.LocationUUID,
-			"--replicator-location", params.ReplicaLocationUUID,
-		)
-		cmd.Env = cmd.Environ()
-		if len(management.Host.Environment) > 0 {
-			keys := make([]string, 0, len(management.Host.Environment))
-			for key := range management.Host.Environment {
-				keys = append(keys, key)
-			}
-			sort.Strings(keys)
-			for _, key := range keys {
-				cmd.Env = append(cmd.Env, fmt.Sprintf("%s=%s", key, management.Host.Environment[key]))
-			}
-		}
-	default:
-		return nil, fmt.Errorf("unsupported storage service management mode %q", management.Mode)
+	// The AIP is replicated from where it is stored, which is not the
+	// location of the workflow once the AIP has been moved.
+	storeLocation := storage_service.ResourceUUID(ssPackage.CurrentLocation)
+	if storeLocation == "" {
+		storeLocation = params.LocationUUID
+	}
+
+	management := a.management(params.Settings)
+	cmd, err := management.command(
+		ctx,
+		"create_aip_replicas",
+		"--aip-uuid", aip.UUID,
+		"--aip-store-location", storeLocation,
+		"--replicator-location", params.ReplicaLocationUUID,
+	)
+	if err != nil {
+		return nil, err
 	}
 
 	q := models.AipReplications.Query(
@@ -263,6 +395,34 @@ func (a *App) ReplicateA(ctx context.Context, params ReplicateParams) (*Replicat
 		result.Status = aipReplication.Status
 		return result, nil
 	}
+
+	// Do not create a second copy in a target that already holds a replica,
+	// e.g. one made outside of migrate or by an attempt that was not recorded.
+	replicas, err := a.replicasByLocation(ctx, ssPackage)
+	if err != nil {
+		return nil, err
+	}
+	// A replica that failed the fixity check is replaced by a new one.
+	failed := failedReplica(aipReplication)
+	if replica, ok := replicas[params.ReplicaLocationUUID]; ok && replica.Status == "UPLOADED" && replica.UUID != failed {
+		d := "Replica already in location: " + replica.UUID
+		e.AddDetail(d)
+		result.Details = append(result.Details, d)
+		if err := aipReplication.Update(ctx, a.DB, &models.AipReplicationSetter{
+			Status:      omit.From(string(AIPReplicationStatusFinished)),
+			ReplicaUUID: omitnull.From(replica.UUID),
+		}); err != nil {
+			return nil, err
+		}
+		if err := EndEventNoChange(ctx, a, e, aip); err != nil {
+			return nil, err
+		}
+		result.Status = string(AIPReplicationStatusFinished)
+		result.ReplicaUUID = replica.UUID
+		result.Existing = true
+		return result, nil
+	}
+
 	if err := a.UpdateAIPStatus(ctx, aip.ID, AIPStatusReplicationInProgress); err != nil {
 		return nil, err
 	}
@@ -281,70 +441,133 @@ func (a *App) ReplicateA(ctx context.Context, params ReplicateParams) (*Replicat
 	result.Command = cmd.String()
 	logger.Info("Replicating AIP", "command", cmd.String())
 
-	if output, err := cmd.CombinedOutput(); err != nil {
+	stopHeartbeat := heartbeat(ctx)
+	output, err := cmd.CombinedOutput()
+	stopHeartbeat()
+	e.AddDetail(string(output))
+	result.Details = append(result.Details, strings.Split(strings.TrimSpace(string(output)), "\n")...)
+
+	parsed := parseReplicationOutput(string(output))
+	if parsed.Message != "" {
+		e.AddDetail("Sentence: " + parsed.Message)
+	}
+
+	switch {
+	case parsed.Outcome == ReplicationNoAIPs:
+		// NOTE: In this case AIP has been deleted.
+		result.Status = string(AIPReplicationStatusFailed)
+		if err := a.updateReplicateAIPStatus(ctx, aipReplication, AIPReplicationStatusFailed); err != nil {
+			return nil, err
+		}
+		e.AddDetail("Not replicated")
+		if eventErr := EndEventErr(ctx, a, e, aip, parsed.Message); eventErr != nil {
+			return nil, eventErr
+		}
+		if eventErr := EndEvent(ctx, AIPStatusDeleted, a, e, aip); eventErr != nil {
+			return nil, eventErr
+		}
+	case err != nil || parsed.Outcome == ReplicationError:
+		if err == nil {
+			err = errors.New(parsed.Message)
+		}
 		if updateErr := a.updateReplicateAIPStatus(ctx, aipReplication, AIPReplicationStatusFailed); updateErr != nil {
 			return nil, errors.Join(err, updateErr)
 		}
-		e.AddDetail(string(output))
-		result.Details = append(result.Details, string(output))
 		if eventErr := EndEventErr(ctx, a, e, aip, err.Error()); eventErr != nil {
 			return nil, errors.Join(err, eventErr)
 		}
 		logger.Error("ERROR", "error", err.Error(), "output", string(output))
 		return nil, err
-	} else {
-		e.AddDetail(string(output))
-		res := strings.Split(string(output), "\n")
-		result.Details = append(result.Details, res...)
-		if len(res) > 0 {
-			sentence := res[len(res)-2]
-			e.AddDetail("Sentence: " + sentence)
-			if strings.Contains(sentence, "New replicas created for 1 of 1 AIPs in location") {
-				// TODO(daniel): Mark AIP Replication as Replicated.
-				result.Status = string(AIPReplicationStatusFinished)
-				if err := a.updateReplicateAIPStatus(ctx, aipReplication, AIPReplicationStatusFinished); err != nil {
-					return nil, err
-				}
-				if err := EndEventNoChange(ctx, a, e, aip); err != nil {
-					return nil, err
-				}
-			} else if strings.Contains(sentence, "New replicas created for 0 of 1 AIPs in location.") {
-				// TODO(daniel): Mark AIP Replication as Stalled/Unknown.
-				if err := a.updateReplicateAIPStatus(ctx, aipReplication, AIPReplicationStatusUnknown); err != nil {
-					return nil, err
-				}
-				e.AddDetail("Not replicated")
-				if eventErr := EndEventErr(ctx, a, e, aip, sentence); eventErr != nil {
-					return nil, eventErr
-				}
-				return nil, err
-			} else if strings.Contains(sentence, "CommandError: No AIPs to replicate in location") {
-				// NOTE: In this case AIP has been deleted.
-				if err := a.updateReplicateAIPStatus(ctx, aipReplication, AIPReplicationStatusFailed); err != nil {
-					return nil, err
-				}
-				e.AddDetail("Not replicated")
-				if eventErr := EndEventErr(ctx, a, e, aip, sentence); eventErr != nil {
-					return nil, eventErr
-				}
-				if eventErr := EndEvent(ctx, AIPStatusDeleted, a, e, aip); eventErr != nil {
-					return nil, eventErr
-				}
+	case parsed.Outcome == ReplicationCreated:
+		replicaUUID, err := a.replicaUUID(ctx, aip.UUID, params.ReplicaLocationUUID, parsed.ReplicaUUIDs, failed)
+		if err != nil {
+			return nil, err
+		}
+		// The new replica has not been verified yet.
+		setter := &models.AipReplicationSetter{
+			Status:          omit.From(string(AIPReplicationStatusFinished)),
+			FixityStatus:    omitnull.FromPtr[string](nil),
+			FixityCheckedAt: omitnull.FromPtr[string](nil),
+		}
+		if replicaUUID != "" {
+			setter.ReplicaUUID = omitnull.From(replicaUUID)
+			e.AddDetail("Replica: " + replicaUUID)
+			result.ReplicaUUID = replicaUUID
+		}
+		if err := aipReplication.Update(ctx, a.DB, setter); err != nil {
+			return nil, err
+		}
+		result.Status = string(AIPReplicationStatusFinished)
+		if err := EndEventNoChange(ctx, a, e, aip); err != nil {
+			return nil, err
+		}
+	case parsed.Outcome == ReplicationNoneCreated:
+		result.Status = string(AIPReplicationStatusUnknown)
+		if err := a.updateReplicateAIPStatus(ctx, aipReplication, AIPReplicationStatusUnknown); err != nil {
+			return nil, err
+		}
+		e.AddDetail("Not replicated")
+		if eventErr := EndEventErr(ctx, a, e, aip, parsed.Message); eventErr != nil {
+			return nil, eventErr
+		}
+	default:
+		if err := a.updateReplicateAIPStatus(ctx, aipReplication, AIPReplicationStatusUnknown); err != nil {
+			return nil, err
+		}
+		logger.Info("Replication command returned", "output", string(output))
+		if eventErr := EndEventErr(ctx, a, e, aip, "Could not determine result of Replication"); eventErr != nil {
+			return nil, eventErr
+		}
+		return nil, errors.New("could not determine result of replication")
+	}
+
+	return result, nil
+}
+
+// replicaUUID returns the UUID of the replica of the AIP stored in the
+// location, other than the failed one. The replicas reported by the command
+// are tried first, then every replica the Storage Service lists for the AIP.
+// It is empty when none is found.
+func (a *App) replicaUUID(ctx context.Context, aipUUID, locationUUID string, reported []string, failed string) (string, error) {
+	find := func(ids []string) (string, error) {
+		for _, id := range ids {
+			if id == failed {
+				continue
 			}
-		} else {
-			// TODO(daniel): Mark AIP Replication as Stalled/Unknown.
-			if err := a.updateReplicateAIPStatus(ctx, aipReplication, AIPReplicationStatusUnknown); err != nil {
-				return nil, err
+			replica, err := a.StorageClient.Packages.GetByID(ctx, id)
+			if errors.Is(err, storage_service.ErrNotFound) {
+				continue
+			} else if err != nil {
+				return "", err
 			}
-			logger.Info("Replication command returned", "output", string(output))
-			if eventErr := EndEventErr(ctx, a, e, aip, "Could not determine result of Replication"); eventErr != nil {
-				return nil, eventErr
+			if storage_service.ResourceUUID(replica.CurrentLocation) == locationUUID && replica.Status == "UPLOADED" {
+				return replica.UUID, nil
 			}
-			return nil, errors.New("could not determine result of replication")
 		}
+		return "", nil
 	}
 
-	return result, nil
+	if id, err := find(reported); id != "" || err != nil {
+		return id, err
+	}
+	pkg, err := a.StorageClient.Packages.GetByID(ctx, aipUUID)
+	if err != nil {
+		return "", err
+	}
+	ids := make([]string, len(pkg.Replicas))
+	for i, uri := range pkg.Replicas {
+		ids[i] = storage_service.ResourceUUID(uri)
+	}
+	return find(ids)
+}
+
+// failedReplica returns the UUID of the replica recorded for the replication
+// when it failed the fixity check, or an empty string.
+func failedReplica(r *models.AipReplication) string {
+	if r.FixityStatus.GetOrZero() != string(ReplicaFixityStatusFailed) {
+		return ""
+	}
+	return r.ReplicaUUID.GetOrZero()
 }
 
 type FindParams struct {
@@ -384,6 +607,10 @@ const CheckReplicationStatusName = "check-replication-status"
 
 type CheckReplicationStatusParams struct {
 	AIP_UUID string
+
+	// RequireFixity only marks the AIP replicated once every replica passed
+	// the fixity check run by VerifyReplicasA.
+	RequireFixity bool
 }
 
 func (a *App) CheckReplicationStatus(ctx context.Context, params CheckReplicationStatusParams) error {
@@ -400,9 +627,13 @@ func (a *App) CheckReplicationStatus(ctx context.Context, params CheckReplicatio
 	finishedCount := 0
 	for _, r := range aip.R.AipReplications {
 		logger.Info("AIP Replication", "Status", r.Status, "AIP UUID", aip.UUID)
-		if r.Status == string(AIPReplicationStatusFinished) {
-			finishedCount++
+		if r.Status != string(AIPReplicationStatusFinished) {
+			continue
+		}
+		if params.RequireFixity && r.FixityStatus.GetOrZero() != string(ReplicaFixityStatusPassed) {
+			continue
 		}
+		finishedCount++
 	}
 	if len(aip.R.AipReplications) == finishedCount {
 		if err := a.UpdateAIPStatus(ctx, aip.ID, AIPStatusReplicated); err != nil {
@@ -425,8 +656,8 @@ func NewCheckStorageServiceConnectionActivity(storageClient *storage_service.API
 
 func (a *CheckStorageServiceConnectionActivity) Execute(ctx context.Context, locations StorageServiceLocationConfig) error {
 	logger := activity.GetLogger(ctx)
-	for _, l := range locations.ReplicationTargets {
-		loc, err := a.StorageClient.Location.Get(ctx, l.ID)
+	for _, id := range locations.replicationLocations() {
+		loc, err := a.StorageClient.Location.Get(ctx, id)
 		if err != nil {
 			return fmt.Errorf("error connecting with the SS: %w", err)
 		}
@@ -464,32 +695,37 @@ func find(ctx context.Context, logger *slog.Logger, a *App, storageClient *stora
 			}
 			return err
 		}
-
-		logger.Info("AIP found", "UUID", ssPackage.UUID)
-		sizeVal := omitnull.Val[int64]{}
-		if ssPackage.Size > math.MaxInt64 {
-			logger.Warn("package size exceeds supported range", "uuid", ssPackage.UUID, "size", ssPackage.Size)
-		} else {
-			sizeVal = omitnull.From(int64(ssPackage.Size))
-		}
-
-		found := true
-		status := AIPStatusFound
-		if ssPackage.Status == "Deleted" {
-			found = false
-			status = AIPStatusDeleted
-		}
-		if err := a.UpdateAIP(ctx, aip.ID,
-			&models.AipSetter{
-				Found: omit.From(found),
-				Size:  sizeVal,
-			},
-		); err != nil {
-			return err
-		}
-		if err := EndEvent(ctx, status, a, e, aip); err != nil {
+		if err := recordPackage(ctx, logger, a, e, aip, ssPackage); err != nil {
 			return err
 		}
 	}
 	return nil
 }
+
+// recordPackage records the size and status of the package found for the
+// AIP.
+func recordPackage(ctx context.Context, logger *slog.Logger, a *App, e Event, aip *models.Aip, ssPackage *storage_service.Package) error {
+	logger.Info("AIP found", "UUID", ssPackage.UUID)
+	sizeVal := omitnull.Val[int64]{}
+	if ssPackage.Size > math.MaxInt64 {
+		logger.Warn("package size exceeds supported range", "uuid", ssPackage.UUID, "size", ssPackage.Size)
+	} else {
+		sizeVal = omitnull.From(int64(ssPackage.Size))
+	}
+
+	found := true
+	status := AIPStatusFound
+	if ssPackage.Status == "Deleted" {
+		found = false
+		status = AIPStatusDeleted
+	}
+	if err := a.UpdateAIP(ctx, aip.ID,
+		&models.AipSetter{
+			Found: omit.From(found),
+			Size:  sizeVal,
+		},
+	); err != nil {
+		return err
+	}
+	return EndEvent(ctx, status, a, e, aip)
+}
@@ -0,0 +1,196 @@
+package application
+
+import (
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/aarondl/opt/omit"
+	"github.com/aarondl/opt/omitnull"
+	"github.com/google/uuid"
+	"go.temporal.io/sdk/activity"
+	"go.temporal.io/sdk/testsuite"
+	"gotest.tools/v3/assert"
+
+	"github.com/artefactual-labs/migrate/internal/database/gen/models"
+	"github.com/artefactual-labs/migrate/internal/storage_service"
+)
+
+func TestInitAIPInDatabase(t *testing.T) {
+	t.Parallel()
+
+	t.Run("Uses the locations of the submitted settings", func(t *testing.T) {
+		t.Parallel()
+
+		app := newTestApp(t)
+		app.Config.StorageService.Locations.ReplicationTargets = []ReplicationTarget{{ID: "worker-replica"}}
+		settings := app.Config.WorkflowSettings()
+		settings.Locations.ReplicationTargets = []ReplicationTarget{{ID: "submitted-replica"}}
+
+		res, err := app.InitAIPInDatabase(t.Context(), uuid.New(), settings)
+		assert.NilError(t, err)
+		assert.DeepEqual(t, res.DesiredReplication, []string{"submitted-replica"})
+	})
+
+	t.Run("Uses the locations of the worker without settings", func(t *testing.T) {
+		t.Parallel()
+
+		app := newTestApp(t)
+		app.Config.StorageService.Locations.ReplicationTargets = []ReplicationTarget{{ID: "worker-replica"}}
+
+		res, err := app.InitAIPInDatabase(t.Context(), uuid.New(), nil)
+		assert.NilError(t, err)
+		assert.DeepEqual(t, res.DesiredReplication, []string{"worker-replica"})
+	})
+}
+
+func TestReplicateA(t *testing.T) {
+	t.Parallel()
+
+	const (
+		aipUUID    = "6f1d2c3b-4a5e-4f6a-8b7c-9d0e1f2a3b4c"
+		source     = "source-location"
+		location   = "replica-location"
+		uploaded   = "0a1b2c3d-4e5f-4a6b-8c7d-8e9f0a1b2c3d"
+		deleted    = "1b2c3d4e-5f6a-4b7c-9d8e-9f0a1b2c3d4e"
+		elsewhere  = "2c3d4e5f-6a7b-4c8d-ae9f-0a1b2c3d4e5f"
+		newReplica = "3d4e5f6a-7b8c-4d9e-bf0a-1b2c3d4e5f6a"
+	)
+
+	packages := map[string]storage_service.Package{
+		uploaded:   {UUID: uploaded, Status: "UPLOADED", CurrentLocation: "/api/v2/location/" + location + "/"},
+		deleted:    {UUID: deleted, Status: "DELETED", CurrentLocation: "/api/v2/location/" + location + "/"},
+		elsewhere:  {UUID: elsewhere, Status: "UPLOADED", CurrentLocation: "/api/v2/location/other-location/"},
+		newReplica: {UUID: newReplica, Status: "UPLOADED", CurrentLocation: "/api/v2/location/" + location + "/"},
+	}
+	created := "New replicas created for 1 of 1 AIPs in location " + location + "\nCreated replica " + newReplica
+
+	// setup returns an activity environment running ReplicateA for aip, to be
+	// replicated to location, against a Storage Service listing aipPackage and
+	// its replicas, and the arguments of the replication command.
+	setup := func(t *testing.T, aip *models.AipSetter, aipPackage storage_service.Package) (*App, *testsuite.TestActivityEnvironment, func() []string) {
+		app := newTestApp(t)
+		aip.UUID = omit.From(aipUUID)
+		inserted, err := models.Aips.Insert(aip).One(t.Context(), app.DB)
+		assert.NilError(t, err)
+		assert.NilError(t, inserted.InsertAipReplications(t.Context(), app.DB, &models.AipReplicationSetter{
+			LocationUUID: omitnull.From(location),
+			Status:       omit.From(string(AIPReplicationStatusNew)),
+		}))
+
+		aipPackage.UUID, aipPackage.Status = aipUUID, "UPLOADED"
+		app.StorageClient = newTestStorageService(t, func(w http.ResponseWriter, r *http.Request) {
+			if r.URL.Path == "/api/v2/file/"+aipUUID+"/" {
+				_ = json.NewEncoder(w).Encode(aipPackage)
+				return
+			}
+			for id, pkg := range packages {
+				if r.URL.Path == "/api/v2/file/"+id+"/" {
+					_ = json.NewEncoder(w).Encode(pkg)
+					return
+				}
+			}
+			http.NotFound(w, r)
+		})
+		var args func() []string
+		app.Config.StorageService.Management, args = newTestManagement(t, created, 0)
+
+		var suite testsuite.WorkflowTestSuite
+		env := suite.NewTestActivityEnvironment()
+		env.RegisterActivityWithOptions(app.ReplicateA, activity.RegisterOptions{Name: ReplicateAName})
+		return app, env, args
+	}
+
+	replicate := func(t *testing.T, env *testsuite.TestActivityEnvironment, storeLocation string) ReplicateResult {
+		t.Helper()
+		val, err := env.ExecuteActivity(ReplicateAName, ReplicateParams{AipID: aipUUID, LocationUUID: storeLocation, ReplicaLocationUUID: location})
+		assert.NilError(t, err)
+		var res ReplicateResult
+		assert.NilError(t, val.Get(&res))
+		return res
+	}
+
+	t.Run("Uses the replica already in the location", func(t *testing.T) {
+		t.Parallel()
+
+		for _, tc := range []struct {
+			name         string
+			replicas     []string
+			wantExisting bool
+			wantReplica  string
+		}{
+			{
+				name:         "Uploaded replica listed before a deleted one",
+				replicas:     []string{uploaded, deleted},
+				wantExisting: true,
+				wantReplica:  uploaded,
+			},
+			{
+				name:         "Uploaded replica listed after a deleted one",
+				replicas:     []string{deleted, uploaded},
+				wantExisting: true,
+				wantReplica:  uploaded,
+			},
+			{
+				name:        "Deleted replica only",
+				replicas:    []string{deleted},
+				wantReplica: newReplica,
+			},
+			{
+				name:        "Replicas in other locations",
+				replicas:    []string{elsewhere},
+				wantReplica: newReplica,
+			},
+		} {
+			t.Run(tc.name, func(t *testing.T) {
+				t.Parallel()
+
+				var aipPackage storage_service.Package
+				for _, id := range tc.replicas {
+					aipPackage.Replicas = append(aipPackage.Replicas, "/api/v2/file/"+id+"/")
+				}
+				app, env, _ := setup(t, &models.AipSetter{Status: omit.From(string(AIPStatusFound))}, aipPackage)
+
+				res := replicate(t, env, source)
+				assert.Equal(t, res.Status, string(AIPReplicationStatusFinished))
+				assert.Equal(t, res.Existing, tc.wantExisting)
+				assert.Equal(t, res.ReplicaUUID, tc.wantReplica)
+				// Nothing is run when the location already holds a replica.
+				assert.Equal(t, res.Command == "", tc.wantExisting)
+
+				r, err := models.AipReplications.Query(
+					models.SelectWhere.AipReplications.LocationUUID.EQ(location),
+				).One(t.Context(), app.DB)
+				assert.NilError(t, err)
+				assert.Equal(t, r.Status, string(AIPReplicationStatusFinished))
+				assert.Equal(t, r.ReplicaUUID.GetOrZero(), tc.wantReplica)
+			})
+		}
+	})
+
+	t.Run("Replicates a moved AIP from its mapped move target", func(t *testing.T) {
+		t.Parallel()
+
+		app, env, args := setup(t, &models.AipSetter{
+			Status:                 omit.From(string(AIPStatusMoved)),
+			Moved:                  omit.From(true),
+			SourceLocationUUID:     omit.From("mapped-source"),
+			MoveTargetLocationUUID: omit.From("mapped-target"),
+		}, storage_service.Package{CurrentLocation: "/api/v2/location/mapped-target/"})
+
+		init, err := app.InitAIPInDatabase(t.Context(), uuid.MustParse(aipUUID), nil)
+		assert.NilError(t, err)
+		assert.Equal(t, init.storeLocation(init.locations(app.Config.StorageService.Locations)), "mapped-target")
+
+		// The location of the package wins over the one of the workflow.
+		res := replicate(t, env, "mapped-source")
+		assert.Equal(t, res.ReplicaUUID, newReplica)
+		assert.DeepEqual(t, args(), []string{
+			"create_aip_replicas",
+			"--aip-uuid", aipUUID,
+			"--aip-store-location", "mapped-target",
+			"--replicator-location", location,
+		})
+		assert.Equal(t, getTestAIP(t, app, aipUUID).Status, string(AIPStatusReplicationInProgress))
+	})
+}
@@ -0,0 +1,99 @@
+package application
+
+import (
+	"math"
+	"slices"
+	"strings"
+
+	"github.com/artefactual-labs/migrate/internal/storage_service"
+)
+
+// ruleTarget is a replication target chosen for an AIP and the rule that
+// chose it.
+type ruleTarget struct {
+	LocationID string
+	Rule       string
+}
+
+// replicationTargets returns the replication targets of the package. The
+// default replication_targets apply when pkg is nil, e.g. because the package
+// was not found.
+func (c StorageServiceLocationConfig) replicationTargets(pkg *storage_service.Package) []ruleTarget {
+	var targets []ruleTarget
+	add := func(rule string, ids ...string) {
+		for _, id := range ids {
+			if !slices.ContainsFunc(targets, func(t ruleTarget) bool { return t.LocationID == id }) {
+				targets = append(targets, ruleTarget{LocationID: id, Rule: rule})
+			}
+		}
+	}
+
+	if pkg != nil {
+		for _, r := range c.ReplicationRules {
+			if !r.Match.matches(pkg) {
+				continue
+			}
+			if r.Exclusive {
+				targets = nil
+				add(r.Name, r.Targets...)
+				return targets
+			}
+			add(r.Name, r.Targets...)
+		}
+	}
+
+	for _, t := range c.ReplicationTargets {
+		add(DefaultReplicationRule, t.ID)
+	}
+	return targets
+}
+
+// replicationLocations lists every location AIPs may be replicated to.
+func (c StorageServiceLocationConfig) replicationLocations() []string {
+	var ids []string
+	add := func(id string) {
+		if !slices.Contains(ids, id) {
+			ids = append(ids, id)
+		}
+	}
+	for _, t := range c.ReplicationTargets {
+		add(t.ID)
+	}
+	for _, m := range c.Mappings {
+		for _, t := range m.ReplicationTargets {
+			add(t.ID)
+		}
+	}
+	for _, r := range c.ReplicationRules {
+		for _, id := range r.Targets {
+			add(id)
+		}
+	}
+	return ids
+}
+
+func (m ReplicationRuleMatch) matches(pkg *storage_service.Package) bool {
+	if len(m.OriginPipelines) > 0 && !slices.Contains(m.OriginPipelines, storage_service.ResourceUUID(pkg.OriginPipeline)) {
+		return false
+	}
+	if len(m.Locations) > 0 && !slices.Contains(m.Locations, storage_service.ResourceUUID(pkg.CurrentLocation)) {
+		return false
+	}
+	if len(m.PackageTypes) > 0 && !slices.ContainsFunc(m.PackageTypes, func(t string) bool { return strings.EqualFold(t, pkg.PackageType) }) {
+		return false
+	}
+	size := int64(math.MaxInt64)
+	if pkg.Size <= math.MaxInt64 {
+		size = int64(pkg.Size)
+	}
+	if m.MinSize != 0 && size < int64(m.MinSize) {
+		return false
+	}
+	if m.MaxSize != 0 && size > int64(m.MaxSize) {
+		return false
+	}
+	if m.Encrypted != nil && *m.Encrypted != pkg.Encrypted {
+		return false
+	}
+	return true
+}
@@ -0,0 +1,92 @@
+package application
+
+import (
+	"testing"
+
+	"gotest.tools/v3/assert"
+
+	"github.com/artefactual-labs/migrate/internal/storage_service"
+)
+
+func TestReplicationTargets(t *testing.T) {
+	t.Parallel()
+
+	encrypted := true
+	locations := StorageServiceLocationConfig{
+		ReplicationTargets: []ReplicationTarget{{ID: "default-1"}, {ID: "default-2"}},
+		ReplicationRules: []ReplicationRule{
+			{
+				Name:      "encrypted",
+				Match:     ReplicationRuleMatch{Encrypted: &encrypted},
+				Targets:   []string{"vault"},
+				Exclusive: true,
+			},
+			{
+				Name: "large",
+				Match: ReplicationRuleMatch{
+					OriginPipelines: []string{"pipeline-1"},
+					MinSize:         100,
+				},
+				Targets: []string{"cloud", "default-2"},
+			},
+			{
+				Name:    "dip",
+				Match:   ReplicationRuleMatch{PackageTypes: []string{"DIP"}, MaxSize: 10},
+				Targets: []string{"dips"},
+			},
+		},
+	}
+
+	for _, tc := range []struct {
+		name string
+		pkg  *storage_service.Package
+		want []ruleTarget
+	}{
+		{
+			name: "Not found",
+			want: []ruleTarget{
+				{LocationID: "default-1", Rule: DefaultReplicationRule},
+				{LocationID: "default-2", Rule: DefaultReplicationRule},
+			},
+		},
+		{
+			name: "No rule matches",
+			pkg:  &storage_service.Package{OriginPipeline: "/api/v2/pipeline/pipeline-1/", PackageType: "AIP", Size: 99},
+			want: []ruleTarget{
+				{LocationID: "default-1", Rule: DefaultReplicationRule},
+				{LocationID: "default-2", Rule: DefaultReplicationRule},
+			},
+		},
+		{
+			name: "Rule adds targets",
+			pkg:  &storage_service.Package{OriginPipeline: "/api/v2/pipeline/pipeline-1/", PackageType: "AIP", Size: 100},
+			want: []ruleTarget{
+				{LocationID: "cloud", Rule: "large"},
+				{LocationID: "default-2", Rule: "large"},
+				{LocationID: "default-1", Rule: DefaultReplicationRule},
+			},
+		},
+		{
+			name: "Package type is case insensitive",
+			pkg:  &storage_service.Package{PackageType: "dip", Size: 10},
+			want: []ruleTarget{
+				{LocationID: "dips", Rule: "dip"},
+				{LocationID: "default-1", Rule: DefaultReplicationRule},
+				{LocationID: "default-2", Rule: DefaultReplicationRule},
+			},
+		},
+		{
+			name: "Exclusive rule",
+			pkg:  &storage_service.Package{OriginPipeline: "/api/v2/pipeline/pipeline-1/", Size: 100, Encrypted: true},
+			want: []ruleTarget{
+				{LocationID: "vault", Rule: "encrypted"},
+			},
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			assert.DeepEqual(t, locations.replicationTargets(tc.pkg), tc.want)
+		})
+	}
+}
@@ -1,8 +1,14 @@
 package application
 
 import (
+	"cmp"
+	"context"
+	"fmt"
+	"slices"
 	"sort"
 
+	"github.com/google/uuid"
+
 	"github.com/artefactual-labs/migrate/internal/database/gen/models"
 )
 
@@ -11,3 +17,62 @@ func SortAips(aips []*models.Aip) {
 		return aips[i].Size.GetOrZero() < aips[j].Size.GetOrZero()
 	})
 }
+
+// ValidateOrder reports whether order is a supported submission order.
+func ValidateOrder(order string) error {
+	switch order {
+	case OrderInput, OrderLargestFirst, OrderSmallestFirst, OrderPriority:
+		return nil
+	}
+	return fmt.Errorf("unknown order %q, use %q, %q, %q or %q", order, OrderInput, OrderLargestFirst, OrderSmallestFirst, OrderPriority)
+}
+
+// OrderUUIDs sorts uuids in the given submission order using the sizes and
+// priorities recorded in the database. The sort is stable, so AIPs that
+// compare equal, e.g. because their size is still unknown, keep the input
+// order.
+func (a *App) OrderUUIDs(ctx context.Context, uuids []uuid.UUID, order string) error {
+	if err := ValidateOrder(order); err != nil {
+		return err
+	}
+	if order == OrderInput {
+		return nil
+	}
+
+	aips, err := models.Aips.Query().All(ctx, a.DB)
+	if err != nil {
+		return fmt.Errorf("load AIPs: %w", err)
+	}
+	byUUID := make(map[string]*models.Aip, len(aips))
+	for _, aip := range aips {
+		byUUID[aip.UUID] = aip
+	}
+
+	orderUUIDs(uuids, order, byUUID)
+	return nil
+}
+
+// orderUUIDs sorts uuids in the given order. AIPs missing from aips are
+// treated as having no size and no priority.
+func orderUUIDs(uuids []uuid.UUID, order string, aips map[string]*models.Aip) {
+	key := func(id uuid.UUID) int64 {
+		aip := aips[id.String()]
+		if aip == nil {
+			return 0
+		}
+		switch order {
+		case OrderLargestFirst, OrderSmallestFirst:
+			return aip.Size.GetOrZero()
+		case OrderPriority:
+			return aip.Priority
+		}
+		return 0
+	}
+
+	slices.SortStableFunc(uuids, func(x, y uuid.UUID) int {
+		if order == OrderSmallestFirst {
+			return cmp.Compare(key(x), key(y))
+		}
+		return cmp.Compare(key(y), key(x))
+	})
+}
@@ -0,0 +1,50 @@
+package application
+
+import (
+	"testing"
+
+	"github.com/aarondl/opt/null"
+	"github.com/google/uuid"
+	"gotest.tools/v3/assert"
+
+	"github.com/artefactual-labs/migrate/internal/database/gen/models"
+)
+
+func TestOrderUUIDs(t *testing.T) {
+	t.Parallel()
+
+	small := uuid.MustParse("4e6b4ac6-f1f1-4b4b-8e64-3a1f1a2ba001")
+	large := uuid.MustParse("4e6b4ac6-f1f1-4b4b-8e64-3a1f1a2ba002")
+	urgent := uuid.MustParse("4e6b4ac6-f1f1-4b4b-8e64-3a1f1a2ba003")
+	unknown := uuid.MustParse("4e6b4ac6-f1f1-4b4b-8e64-3a1f1a2ba004")
+	aips := map[string]*models.Aip{
+		small.String():  {UUID: small.String(), Size: null.From(int64(10))},
+		large.String():  {UUID: large.String(), Size: null.From(int64(1000))},
+		urgent.String(): {UUID: urgent.String(), Size: null.From(int64(100)), Priority: 5},
+	}
+	input := []uuid.UUID{small, unknown, large, urgent}
+
+	for _, tc := range []struct {
+		order string
+		want  []uuid.UUID
+	}{
+		{order: OrderLargestFirst, want: []uuid.UUID{large, urgent, small, unknown}},
+		{order: OrderSmallestFirst, want: []uuid.UUID{unknown, small, urgent, large}},
+		{order: OrderPriority, want: []uuid.UUID{urgent, small, unknown, large}},
+	} {
+		t.Run(tc.order, func(t *testing.T) {
+			t.Parallel()
+
+			got := append([]uuid.UUID(nil), input...)
+			orderUUIDs(got, tc.order, aips)
+			assert.DeepEqual(t, got, tc.want)
+		})
+	}
+}
+
+func TestValidateOrder(t *testing.T) {
+	t.Parallel()
+
+	assert.NilError(t, ValidateOrder(OrderInput))
+	assert.ErrorContains(t, ValidateOrder("random"), `unknown order "random"`)
+}
@@ -0,0 +1,147 @@
+package application
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/robfig/cron"
+	"go.temporal.io/sdk/temporal"
+	"go.temporal.io/sdk/workflow"
+)
+
+type scheduleWindow struct {
+	start    cron.Schedule
+	duration time.Duration
+}
+
+type parsedSchedule struct {
+	location *time.Location
+	windows  []scheduleWindow
+}
+
+func (c ScheduleConfig) windows() (*parsedSchedule, error) {
+	s := &parsedSchedule{location: time.Local}
+	if c.Timezone != "" {
+		loc, err := time.LoadLocation(c.Timezone)
+		if err != nil {
+			return nil, fmt.Errorf("invalid schedule.timezone: %w", err)
+		}
+		s.location = loc
+	}
+	for i, w := range c.Windows {
+		start, err := cron.ParseStandard(w.Start)
+		if err != nil {
+			return nil, fmt.Errorf("invalid schedule.windows[%d].start %q: %w", i, w.Start, err)
+		}
+		if w.Duration <= 0 {
+			return nil, fmt.Errorf("schedule.windows[%d].duration must be positive", i)
+		}
+		s.windows = append(s.windows, scheduleWindow{start: start, duration: time.Duration(w.Duration)})
+	}
+	return s, nil
+}
+
+// location returns the timezone of the schedule, which also decides when the
+// days of the daily transfer budgets start. It falls back to the local
+// timezone when the configured one is invalid.
+func (c ScheduleConfig) location() *time.Location {
+	if c.Timezone == "" {
+		return time.Local
+	}
+	loc, err := time.LoadLocation(c.Timezone)
+	if err != nil {
+		return time.Local
+	}
+	return loc
+}
+
+// NextWindow returns when the next window opens, or t itself when a window is
+// open at t or no windows are configured.
+func (c ScheduleConfig) NextWindow(t time.Time) (time.Time, error) {
+	s, err := c.windows()
+	if err != nil {
+		return time.Time{}, err
+	}
+	if len(s.windows) == 0 {
+		return t, nil
+	}
+
+	local := t.In(s.location)
+	var next time.Time
+	for _, w := range s.windows {
+		// The window is open if it started within the last w.duration.
+		if start := w.start.Next(local.Add(-w.duration)); !start.After(local) {
+			return t, nil
+		}
+		if start := w.start.Next(local); !start.IsZero() && (next.IsZero() || start.Before(next)) {
+			next = start
+		}
+	}
+	if next.IsZero() {
+		return time.Time{}, fmt.Errorf("no schedule window opens after %s", t)
+	}
+	return next.In(t.Location()), nil
+}
+
+// waitForWindow blocks the workflow until a maintenance window is open. The
+// AIP is marked as waiting for a window in the meantime. It returns early when
+// the workflow is cancelled.
+func waitForWindow(ctx workflow.Context, schedule ScheduleConfig, control *workflowControl, aipUUID string) error {
+	if v := workflow.GetVersion(ctx, maintenanceWindowChangeID, workflow.DefaultVersion, 1); v == workflow.DefaultVersion {
+		return nil
+	}
+
+	opens, err := schedule.NextWindow(workflow.Now(ctx))
+	if err != nil {
+		return temporal.NewNonRetryableApplicationError(err.Error(), "InvalidSchedule", err)
+	}
+	wait := opens.Sub(workflow.Now(ctx))
+	if wait <= 0 {
+		return nil
+	}
+
+	workflow.GetLogger(ctx).Info("Waiting for the next maintenance window.", "UUID", aipUUID, "opens", opens)
+
+	ctx = workflow.WithActivityOptions(ctx, workflow.ActivityOptions{
+		StartToCloseTimeout: time.Minute,
+		RetryPolicy: &temporal.RetryPolicy{
+			MaximumAttempts: 5,
+		},
+	})
+	var previous UpdateAIPStatusResult
+	params := UpdateAIPStatusParams{UUID: aipUUID, Status: string(AIPStatusWaitingForWindow)}
+	if err := workflow.ExecuteActivity(ctx, UpdateAIPStatusName, params).Get(ctx, &previous); err != nil {
+		return err
+	}
+
+	if _, err := workflow.AwaitWithTimeout(ctx, wait, func() bool { return control.cancelled }); err != nil {
+		return err
+	}
+
+	params.Status = previous.Previous
+	return workflow.ExecuteActivity(ctx, UpdateAIPStatusName, params).Get(ctx, nil)
+}
+
+type UpdateAIPStatusParams struct {
+	UUID   string
+	Status string
+}
+
+type UpdateAIPStatusResult struct {
+	Previous string
+}
+
+const UpdateAIPStatusName = "update-aip-status"
+
+// UpdateAIPStatusA sets the status of the AIP and returns the one it replaced.
+func (a *App) UpdateAIPStatusA(ctx context.Context, params UpdateAIPStatusParams) (*UpdateAIPStatusResult, error) {
+	aip, err := a.GetAIPByID(ctx, params.UUID)
+	if err != nil {
+		return nil, err
+	}
+	if err := a.UpdateAIPStatus(ctx, aip.ID, AIPStatus(params.Status)); err != nil {
+		return nil, err
+	}
+	return &UpdateAIPStatusResult{Previous: aip.Status}, nil
+}
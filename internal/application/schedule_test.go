@@ -0,0 +1,90 @@
+package application
+
+import (
+	"testing"
+	"time"
+
+	"gotest.tools/v3/assert"
+)
+
+func TestScheduleNextWindow(t *testing.T) {
+	t.Parallel()
+
+	// Weeknights from 20:00 to 06:00 and whole weekends.
+	schedule := ScheduleConfig{
+		Timezone: "America/Vancouver",
+		Windows: []ScheduleWindow{
+			{Start: "0 20 * * 1-5", Duration: Duration(10 * time.Hour)},
+			{Start: "0 0 * * 6", Duration: Duration(48 * time.Hour)},
+		},
+	}
+	loc, err := time.LoadLocation("America/Vancouver")
+	assert.NilError(t, err)
+
+	for _, tc := range []struct {
+		name string
+		now  time.Time
+		want time.Time
+	}{
+		{
+			name: "Weekday afternoon waits for the evening",
+			now:  time.Date(2025, 3, 5, 14, 0, 0, 0, loc), // Wednesday.
+			want: time.Date(2025, 3, 5, 20, 0, 0, 0, loc),
+		},
+		{
+			name: "Weekday evening is open",
+			now:  time.Date(2025, 3, 5, 22, 30, 0, 0, loc),
+			want: time.Date(2025, 3, 5, 22, 30, 0, 0, loc),
+		},
+		{
+			name: "Early morning is still open",
+			now:  time.Date(2025, 3, 6, 5, 59, 0, 0, loc),
+			want: time.Date(2025, 3, 6, 5, 59, 0, 0, loc),
+		},
+		{
+			name: "Window closes at six",
+			now:  time.Date(2025, 3, 6, 6, 0, 0, 0, loc),
+			want: time.Date(2025, 3, 6, 20, 0, 0, 0, loc),
+		},
+		{
+			name: "Sunday is open",
+			now:  time.Date(2025, 3, 9, 12, 0, 0, 0, loc),
+			want: time.Date(2025, 3, 9, 12, 0, 0, 0, loc),
+		},
+		{
+			name: "Times in other timezones",
+			now:  time.Date(2025, 3, 5, 22, 0, 0, 0, time.UTC), // 14:00 in Vancouver.
+			want: time.Date(2025, 3, 6, 4, 0, 0, 0, time.UTC),
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := schedule.NextWindow(tc.now)
+			assert.NilError(t, err)
+			assert.Assert(t, got.Equal(tc.want), "got %s, want %s", got, tc.want)
+		})
+	}
+}
+
+func TestScheduleWithoutWindows(t *testing.T) {
+	t.Parallel()
+
+	now := time.Now()
+	got, err := ScheduleConfig{}.NextWindow(now)
+	assert.NilError(t, err)
+	assert.Equal(t, got, now)
+}
+
+func TestScheduleInvalid(t *testing.T) {
+	t.Parallel()
+
+	_, err := ScheduleConfig{Windows: []ScheduleWindow{{Start: "every night", Duration: Duration(time.Hour)}}}.NextWindow(time.Now())
+	assert.ErrorContains(t, err, "invalid schedule.windows[0].start")
+
+	_, err = ScheduleConfig{Windows: []ScheduleWindow{{Start: "0 20 * * *"}}}.NextWindow(time.Now())
+	assert.ErrorContains(t, err, "duration must be positive")
+
+	_, err = ScheduleConfig{Timezone: "Mars/Olympus_Mons"}.NextWindow(time.Now())
+	assert.ErrorContains(t, err, "invalid schedule.timezone")
+}
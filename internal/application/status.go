@@ -0,0 +1,314 @@
+package application
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"slices"
+	"strings"
+	"text/tabwriter"
+	"time"
+
+	"github.com/artefactual-labs/migrate/internal/database/gen/models"
+)
+
+// Status is a snapshot of the progress of the migration.
+type Status struct {
+	GeneratedAt time.Time `json:"generated_at"`
+
+	// Batch is the name of the batch the status is scoped to, if any.
+	Batch string `json:"batch,omitempty"`
+
+	// Number of AIPs by AIPStatus.
+	AIPs          map[string]int `json:"aips"`
+	TotalAIPs     int            `json:"total_aips"`
+	DoneAIPs      int            `json:"done_aips"`
+	RemainingAIPs int            `json:"remaining_aips"`
+
+	// Number of replications by AIPReplicationStatus.
+	Replications map[string]int `json:"replications"`
+
+	TotalBytes     int64 `json:"total_bytes"`
+	DoneBytes      int64 `json:"done_bytes"`
+	RemainingBytes int64 `json:"remaining_bytes"`
+
+	// Moves and replications that ended within the window.
+	Window         time.Duration `json:"window_ns"`
+	WindowAIPs     int           `json:"window_aips"`
+	WindowBytes    int64         `json:"window_bytes"`
+	BytesPerSecond float64       `json:"bytes_per_second"`
+
+	// Average duration of the moves and replications recorded so far, and the
+	// estimated time to process the remaining AIPs based on it.
+	AverageDuration time.Duration `json:"average_duration_ns"`
+	EstimatedLeft   time.Duration `json:"estimated_left_ns"`
+	EstimatedEnd    *time.Time    `json:"estimated_end,omitempty"`
+
+	// NextWindow is when the next maintenance window opens, set only while
+	// the schedule keeps new moves and replications waiting.
+	NextWindow *time.Time `json:"next_window,omitempty"`
+}
+
+// doneStatuses are the AIP statuses that need no more work.
+var doneStatuses = []AIPStatus{
+	AIPStatusMoved,
+	AIPStatusCleaned,
+	AIPStatusReplicated,
+	AIPStatusIndexed,
+	AIPStatusFinished,
+}
+
+// skippedStatuses are the AIP statuses that will never be processed.
+var skippedStatuses = []AIPStatus{
+	AIPStatusNotFound,
+	AIPStatusDeleted,
+	AIPStatusUnmappedLocation,
+}
+
+// failedStatuses are the AIP statuses of failed workflows, counted as
+// remaining since the AIPs can be processed again.
+var failedStatuses = []AIPStatus{
+	AIPStatusFailed,
+	AIPStatusDestinationFixityFailed,
+}
+
+// Status reads the database and summarizes the progress of the migration, or
+// of the batch when it is not nil. Throughput is measured over the given
+// window. Without recent throughput, the estimate assumes
+// workflows.batch.max_concurrent AIPs are processed at the same time.
+func (a *App) Status(ctx context.Context, window time.Duration, batch *models.Batch) (*Status, error) {
+	now := time.Now()
+	totals, err := a.statusTotals(ctx, now.Add(-window), batch)
+	if err != nil {
+		return nil, err
+	}
+
+	concurrency := max(a.Config.Workflows.Batch.MaxConcurrent, 1)
+
+	status := computeStatus(now, window, concurrency, totals)
+	if batch != nil {
+		status.Batch = batch.Name
+	}
+
+	opens, err := a.Config.Schedule.NextWindow(now)
+	if err != nil {
+		return nil, err
+	}
+	if opens.After(now) {
+		status.NextWindow = &opens
+	}
+
+	return status, nil
+}
+
+// statusTotals are the figures the status is computed from.
+type statusTotals struct {
+	// Number and bytes of AIPs by AIPStatus.
+	AIPs      map[string]int
+	AIPsBytes map[string]int64
+
+	// Number of replications by AIPReplicationStatus.
+	Replications map[string]int
+
+	// Successful moves and replications with a duration, and their total
+	// duration.
+	Durations     int
+	TotalDuration time.Duration
+
+	// AIPs moved or replicated since the start of the window, and their size.
+	WindowAIPs  int
+	WindowBytes int64
+}
+
+// statusTotals aggregates the AIPs, replications and events of the batch, or of
+// every AIP when batch is nil, in the database.
+func (a *App) statusTotals(ctx context.Context, since time.Time, batch *models.Batch) (*statusTotals, error) {
+	var batchID int64
+	if batch != nil {
+		batchID = batch.ID
+	}
+	t := &statusTotals{
+		AIPs:         map[string]int{},
+		AIPsBytes:    map[string]int64{},
+		Replications: map[string]int{},
+	}
+
+	// The queries of events take the batch ID, zero for every AIP, the start
+	// of the window and the actions of moves and replications.
+	const inBatch = `(?1 = 0 OR aip_id IN (SELECT aip_id FROM batch_aips WHERE batch_id = ?1))`
+	const transfers = `action IN (?3, ?4) AND success`
+	args := []any{batchID, since.Unix(), ActionMove.String(), ActionReplicate.String()}
+
+	// The AIPs of the batch that joined a later batch since are counted with
+	// the status they ended this one with.
+	err := a.queryRows(ctx, func(scan func(...any) error) error {
+		var status string
+		var count int
+		var size int64
+		if err := scan(&status, &count, &size); err != nil {
+			return err
+		}
+		t.AIPs[status] = count
+		t.AIPsBytes[status] = size
+		return nil
+	}, `SELECT status, COUNT(*), COALESCE(SUM("size"), 0) FROM (
+			SELECT status, "size" FROM aips WHERE ?1 = 0
+			UNION ALL
+			SELECT IIF(batch_aips.status <> '', batch_aips.status, aips.status), aips."size"
+			FROM batch_aips JOIN aips ON aips.id = batch_aips.aip_id
+			WHERE batch_aips.batch_id = ?1
+		)
+		GROUP BY status`, batchID)
+	if err != nil {
+		return nil, fmt.Errorf("count AIPs: %w", err)
+	}
+
+	err = a.queryRows(ctx, func(scan func(...any) error) error {
+		var status string
+		var count int
+		if err := scan(&status, &count); err != nil {
+			return err
+		}
+		t.Replications[status] = count
+		return nil
+	}, `SELECT status, COUNT(*) FROM aip_replication WHERE `+inBatch+` GROUP BY status`, batchID)
+	if err != nil {
+		return nil, fmt.Errorf("count replications: %w", err)
+	}
+
+	err = a.queryRows(ctx, func(scan func(...any) error) error {
+		var total int64
+		if err := scan(&t.Durations, &total); err != nil {
+			return err
+		}
+		t.TotalDuration = time.Duration(total)
+		return nil
+	}, `SELECT COUNT(*), COALESCE(SUM(total_duration_nanoseconds), 0) FROM events
+		WHERE `+transfers+` AND total_duration_nanoseconds > 0 AND `+inBatch, args...)
+	if err != nil {
+		return nil, fmt.Errorf("sum event durations: %w", err)
+	}
+
+	// An AIP replicated to several targets is only counted once.
+	err = a.queryRows(ctx, func(scan func(...any) error) error {
+		return scan(&t.WindowAIPs, &t.WindowBytes)
+	}, `SELECT COUNT(*), COALESCE(SUM("size"), 0) FROM aips
+		WHERE id IN (SELECT aip_id FROM events WHERE `+transfers+` AND ended_at > ?2 AND `+inBatch+`)`, args...)
+	if err != nil {
+		return nil, fmt.Errorf("count recent events: %w", err)
+	}
+
+	return t, nil
+}
+
+// queryRows runs the query and calls fn with the scan function of each row.
+func (a *App) queryRows(ctx context.Context, fn func(scan func(...any) error) error, query string, args ...any) error {
+	rows, err := a.DB.QueryContext(ctx, query, args...)
+	if err != nil {
+		return err
+	}
+	defer rows.Close() //nolint:errcheck
+
+	for rows.Next() {
+		if err := fn(rows.Scan); err != nil {
+			return err
+		}
+	}
+	return rows.Err()
+}
+
+func computeStatus(now time.Time, window time.Duration, concurrency int, totals *statusTotals) *Status {
+	s := &Status{
+		GeneratedAt:  now,
+		AIPs:         totals.AIPs,
+		Replications: totals.Replications,
+		Window:       window,
+		WindowAIPs:   totals.WindowAIPs,
+		WindowBytes:  totals.WindowBytes,
+	}
+
+	for status, count := range totals.AIPs {
+		size := totals.AIPsBytes[status]
+		s.TotalAIPs += count
+		s.TotalBytes += size
+		switch {
+		case slices.Contains(doneStatuses, AIPStatus(status)):
+			s.DoneAIPs += count
+			s.DoneBytes += size
+		case slices.Contains(skippedStatuses, AIPStatus(status)):
+		default:
+			s.RemainingAIPs += count
+			s.RemainingBytes += size
+		}
+	}
+
+	if window > 0 {
+		s.BytesPerSecond = float64(s.WindowBytes) / window.Seconds()
+	}
+
+	if totals.Durations > 0 {
+		s.AverageDuration = totals.TotalDuration / time.Duration(totals.Durations)
+		s.EstimatedLeft = s.AverageDuration * time.Duration(s.RemainingAIPs) / time.Duration(concurrency)
+		// Prefer the observed throughput when there is one, it already
+		// accounts for concurrency and AIP sizes.
+		if s.BytesPerSecond > 0 && s.RemainingBytes > 0 {
+			s.EstimatedLeft = time.Duration(float64(s.RemainingBytes) / s.BytesPerSecond * float64(time.Second))
+		}
+		end := now.Add(s.EstimatedLeft)
+		s.EstimatedEnd = &end
+	}
+
+	return s
+}
+
+// WriteText writes a human readable summary of the status.
+func (s *Status) WriteText(w io.Writer) error {
+	var b strings.Builder
+
+	if s.Batch != "" {
+		fmt.Fprintf(&b, "Status of batch %s at %s\n\n", s.Batch, s.GeneratedAt.Format(time.DateTime))
+	} else {
+		fmt.Fprintf(&b, "Status at %s\n\n", s.GeneratedAt.Format(time.DateTime))
+	}
+
+	fmt.Fprintf(&b, "AIPs\t%d\n", s.TotalAIPs)
+	for _, status := range sortedKeys(s.AIPs) {
+		fmt.Fprintf(&b, "  %s\t%d\n", status, s.AIPs[status])
+	}
+	if len(s.Replications) > 0 {
+		fmt.Fprintf(&b, "Replications\t\n")
+		for _, status := range sortedKeys(s.Replications) {
+			fmt.Fprintf(&b, "  %s\t%d\n", status, s.Replications[status])
+		}
+	}
+	b.WriteString("\n")
+
+	fmt.Fprintf(&b, "Done\t%d AIPs\t%s\n", s.DoneAIPs, formatByteSize(s.DoneBytes))
+	fmt.Fprintf(&b, "Remaining\t%d AIPs\t%s\n", s.RemainingAIPs, formatByteSize(s.RemainingBytes))
+	fmt.Fprintf(&b, "Last %s\t%d AIPs\t%s/s\n", s.Window, s.WindowAIPs, formatByteSize(int64(s.BytesPerSecond)))
+	if s.EstimatedEnd != nil {
+		fmt.Fprintf(&b, "Average duration\t%s\t\n", s.AverageDuration.Round(time.Second))
+		fmt.Fprintf(&b, "Estimated completion\t%s\t(%s left)\n", s.EstimatedEnd.Format(time.DateTime), s.EstimatedLeft.Round(time.Second))
+	} else {
+		fmt.Fprintf(&b, "Estimated completion\tunknown\t\n")
+	}
+
+	if s.NextWindow != nil {
+		fmt.Fprintf(&b, "Maintenance window\tclosed\t(opens %s)\n", s.NextWindow.Format(time.DateTime))
+	}
+
+	tw := tabwriter.NewWriter(w, 0, 0, 2, ' ', 0)
+	if _, err := io.WriteString(tw, b.String()); err != nil {
+		return err
+	}
+	return tw.Flush()
+}
+
+func sortedKeys(m map[string]int) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	slices.Sort(keys)
+	return keys
+}
@@ -0,0 +1,144 @@
+package application
+
+import (
+	"testing"
+	"time"
+
+	"github.com/aarondl/opt/omit"
+	"github.com/aarondl/opt/omitnull"
+	"github.com/google/uuid"
+	"gotest.tools/v3/assert"
+
+	"github.com/artefactual-labs/migrate/internal/database/gen/models"
+)
+
+func TestComputeStatus(t *testing.T) {
+	t.Parallel()
+
+	now := time.Date(2025, 1, 1, 12, 0, 0, 0, time.UTC)
+	const gib = 1 << 30
+
+	totals := &statusTotals{
+		AIPs: map[string]int{"moved": 1, "indexed": 1, "found": 1, "not-found": 1},
+		AIPsBytes: map[string]int64{
+			"moved":   2 * gib,
+			"indexed": gib,
+			"found":   4 * gib,
+		},
+		Replications:  map[string]int{"finished": 1, "new": 1},
+		Durations:     2,
+		TotalDuration: 40 * time.Minute,
+		WindowAIPs:    1,
+		WindowBytes:   gib,
+	}
+
+	s := computeStatus(now, time.Hour, 2, totals)
+
+	assert.DeepEqual(t, s.AIPs, map[string]int{"moved": 1, "indexed": 1, "found": 1, "not-found": 1})
+	assert.DeepEqual(t, s.Replications, map[string]int{"finished": 1, "new": 1})
+	assert.Equal(t, s.TotalAIPs, 4)
+	assert.Equal(t, s.DoneAIPs, 2)
+	assert.Equal(t, s.RemainingAIPs, 1)
+	assert.Equal(t, s.DoneBytes, int64(3*gib))
+	assert.Equal(t, s.RemainingBytes, int64(4*gib))
+	assert.Equal(t, s.AverageDuration, 20*time.Minute)
+
+	// 4 GiB left at 1 GiB per hour.
+	assert.Equal(t, s.EstimatedLeft, 4*time.Hour)
+	assert.Equal(t, *s.EstimatedEnd, now.Add(4*time.Hour))
+}
+
+func TestComputeStatusWithoutRecentThroughput(t *testing.T) {
+	t.Parallel()
+
+	now := time.Date(2025, 1, 1, 12, 0, 0, 0, time.UTC)
+	totals := &statusTotals{
+		AIPs:          map[string]int{"moved": 1, "new": 2},
+		Durations:     1,
+		TotalDuration: time.Hour,
+	}
+
+	// Two AIPs left, one hour each, two at a time.
+	s := computeStatus(now, time.Hour, 2, totals)
+	assert.Equal(t, s.EstimatedLeft, time.Hour)
+
+	// Nothing to estimate from.
+	totals.Durations, totals.TotalDuration = 0, 0
+	s = computeStatus(now, time.Hour, 2, totals)
+	assert.Assert(t, s.EstimatedEnd == nil)
+}
+
+func TestStatus(t *testing.T) {
+	t.Parallel()
+
+	ctx := t.Context()
+	app := newTestApp(t)
+	now := time.Now()
+	const gib = 1 << 30
+
+	insertAIP := func(status AIPStatus, size int64) *models.Aip {
+		aip, err := models.Aips.Insert(&models.AipSetter{
+			UUID:   omit.From(uuid.NewString()),
+			Status: omit.From(string(status)),
+			Size:   omitnull.From(size),
+		}).One(ctx, app.DB)
+		assert.NilError(t, err)
+		return aip
+	}
+	insertEvent := func(aip *models.Aip, action Action, success bool, ended time.Time, d time.Duration) {
+		_, err := models.Events.Insert(&models.EventSetter{
+			AipID:                    omit.From(aip.ID),
+			Action:                   omit.From(action.String()),
+			TimeStarted:              omit.From(ended.Add(-d).String()),
+			TimeEnded:                omit.From(ended.String()),
+			TotalDurationNanoseconds: omitnull.From(int64(d)),
+			Success:                  omit.From(success),
+			EndedAt:                  omitnull.From(ended.Unix()),
+		}).Exec(ctx, app.DB)
+		assert.NilError(t, err)
+	}
+
+	cleaned := insertAIP(AIPStatusCleaned, 2*gib)
+	insertEvent(cleaned, ActionMove, true, now.Add(-3*time.Hour), 30*time.Minute)
+
+	// Replicated to two targets within the window.
+	indexed := insertAIP(AIPStatusIndexed, gib)
+	insertEvent(indexed, ActionReplicate, true, now.Add(-30*time.Minute), 10*time.Minute)
+	insertEvent(indexed, ActionReplicate, true, now.Add(-20*time.Minute), 20*time.Minute)
+
+	// Failed moves count neither in the durations nor in the throughput.
+	failed := insertAIP(AIPStatusFailed, 4*gib)
+	insertEvent(failed, ActionMove, false, now.Add(-10*time.Minute), 5*time.Hour)
+	insertEvent(failed, ActionFind, true, now.Add(-10*time.Minute), time.Minute)
+
+	_, err := models.AipReplications.Insert(&models.AipReplicationSetter{
+		AipID:  omit.From(indexed.ID),
+		Status: omit.From(string(AIPReplicationStatusFinished)),
+	}).Exec(ctx, app.DB)
+	assert.NilError(t, err)
+
+	s, err := app.Status(ctx, time.Hour, nil)
+	assert.NilError(t, err)
+	assert.DeepEqual(t, s.AIPs, map[string]int{"cleaned": 1, "indexed": 1, "failed": 1})
+	assert.DeepEqual(t, s.Replications, map[string]int{"finished": 1})
+	assert.Equal(t, s.DoneAIPs, 2)
+	assert.Equal(t, s.DoneBytes, int64(3*gib))
+	assert.Equal(t, s.RemainingAIPs, 1)
+	assert.Equal(t, s.AverageDuration, 20*time.Minute)
+	assert.Equal(t, s.WindowAIPs, 1)
+	assert.Equal(t, s.WindowBytes, int64(gib))
+
+	batch, err := app.EnsureBatch(ctx, "batch-1", BatchOperationMove, nil)
+	assert.NilError(t, err)
+	id, err := uuid.Parse(cleaned.UUID)
+	assert.NilError(t, err)
+	assert.NilError(t, app.AddBatchAIPs(ctx, batch, []uuid.UUID{id}))
+
+	s, err = app.Status(ctx, time.Hour, batch)
+	assert.NilError(t, err)
+	assert.Equal(t, s.Batch, "batch-1")
+	assert.DeepEqual(t, s.AIPs, map[string]int{"cleaned": 1})
+	assert.DeepEqual(t, s.Replications, map[string]int{})
+	assert.Equal(t, s.AverageDuration, 30*time.Minute)
+	assert.Equal(t, s.WindowAIPs, 0)
+}
@@ -2,42 +2,17 @@ package application
 
 import (
 	"bufio"
-	"errors"
 	"fmt"
-	"os"
-
-	"github.com/google/uuid"
+	"io"
 )
 
+// InputFile is the default input file.
 const InputFile = "input.txt"
 
-func LoadInputUUIDs() ([]uuid.UUID, error) {
-	return LoadUUIDsFromFile(InputFile)
-}
-
-func LoadUUIDsFromFile(path string) ([]uuid.UUID, error) {
-	lines, err := readNonEmptyLines(path)
-	if err != nil {
-		return nil, err
-	}
-
-	uuids, err := ValidateUUIDs(lines)
-	if err != nil {
-		return nil, fmt.Errorf("validate UUIDs: %w", err)
-	}
-
-	return uuids, nil
-}
-
-func readNonEmptyLines(path string) ([]string, error) {
-	f, err := os.Open(path)
-	if err != nil {
-		return nil, err
-	}
-	defer func() { err = errors.Join(err, f.Close()) }()
-
+// ReadNonEmptyLines returns the lines read from r, skipping empty lines.
+func ReadNonEmptyLines(r io.Reader) ([]string, error) {
 	var lines []string
-	scanner := bufio.NewScanner(f)
+	scanner := bufio.NewScanner(r)
 	scanner.Split(bufio.ScanLines)
 	for scanner.Scan() {
 		text := scanner.Text()
@@ -47,26 +22,17 @@ func readNonEmptyLines(path string) ([]string, error) {
 		lines = append(lines, text)
 	}
 
-	return lines, errors.Join(err, scanner.Err())
+	return lines, scanner.Err()
 }
 
-func ReadNonEmptyLines(path string) ([]string, error) {
-	return readNonEmptyLines(path)
-}
-
-func WriteLines(path string, lines []string) (err error) {
-	f, err := os.Create(path)
-	if err != nil {
-		return err
-	}
-	defer func() { err = errors.Join(err, f.Close()) }()
-
-	w := bufio.NewWriter(f)
+// WriteLines writes the lines to w.
+func WriteLines(w io.Writer, lines []string) error {
+	bw := bufio.NewWriter(w)
 	for _, line := range lines {
-		if _, werr := fmt.Fprintln(w, line); werr != nil {
-			return werr
+		if _, err := fmt.Fprintln(bw, line); err != nil {
+			return err
 		}
 	}
 
-	return errors.Join(err, w.Flush())
+	return bw.Flush()
 }
@@ -0,0 +1,219 @@
+package application
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/aarondl/opt/omit"
+	"github.com/aarondl/opt/omitnull"
+	"go.temporal.io/sdk/temporal"
+
+	"github.com/artefactual-labs/migrate/internal/database/gen/models"
+	"github.com/artefactual-labs/migrate/internal/storage_service"
+)
+
+// VerificationFailedErrorType is the type of the error returned when an AIP
+// does not pass verification.
+const VerificationFailedErrorType = "VerificationFailed"
+
+const VerifyActivityName = "verify-aip"
+
+type VerifyActivityParams struct {
+	UUID string
+
+	// LocationID is the location the AIP is expected to be stored in. The
+	// location is not checked when empty.
+	LocationID string
+
+	// Replicas is the minimum number of replicas the AIP is expected to have.
+	Replicas int
+}
+
+type VerifyActivityResult struct {
+	Details []string
+}
+
+// VerifyA checks with the Storage Service that the AIP is stored where the
+// earlier steps put it.
+func (a *App) VerifyA(ctx context.Context, params VerifyActivityParams) (*VerifyActivityResult, error) {
+	aip, err := a.GetAIPByID(ctx, params.UUID)
+	if err != nil {
+		return nil, err
+	}
+
+	e := StartEvent(ActionVerify)
+	pkg, err := a.StorageClient.Packages.GetByID(ctx, params.UUID)
+	if err != nil {
+		return nil, err
+	}
+
+	var problems []string
+	if pkg.Status != "UPLOADED" {
+		problems = append(problems, fmt.Sprintf("package status is %s", pkg.Status))
+	}
+	if params.LocationID != "" && !strings.Contains(pkg.CurrentLocation, params.LocationID) {
+		problems = append(problems, fmt.Sprintf("package is stored in %s instead of %s", pkg.CurrentLocation, params.LocationID))
+	}
+	if len(pkg.Replicas) < params.Replicas {
+		problems = append(problems, fmt.Sprintf("package has %d replicas, expected %d", len(pkg.Replicas), params.Replicas))
+	}
+
+	result := &VerifyActivityResult{}
+	result.Details = append(result.Details,
+		"Location: "+pkg.CurrentLocation,
+		fmt.Sprintf("Replicas: %d", len(pkg.Replicas)),
+	)
+	for _, d := range result.Details {
+		e.AddDetail(d)
+	}
+
+	if len(problems) > 0 {
+		msg := "verification failed: " + strings.Join(problems, "; ")
+		if err := EndEventErr(ctx, a, e, aip, msg); err != nil {
+			return nil, err
+		}
+		return nil, temporal.NewNonRetryableApplicationError(msg, VerificationFailedErrorType, nil)
+	}
+
+	if err := EndEventNoChange(ctx, a, e, aip); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+type ReplicaFixityStatus string
+
+const (
+	ReplicaFixityStatusPassed  ReplicaFixityStatus = "passed"
+	ReplicaFixityStatusFailed  ReplicaFixityStatus = "failed"
+	ReplicaFixityStatusMissing ReplicaFixityStatus = "missing"
+)
+
+const VerifyReplicasActivityName = "verify-replicas"
+
+type VerifyReplicasActivityParams struct {
+	UUID string
+}
+
+type VerifyReplicasActivityResult struct {
+	Details []string
+}
+
+// VerifyReplicasA runs a fixity check on every replica of the AIP listed by
+// the Storage Service and records the outcome in aip_replication. Replicas
+// that already passed are not checked again, and those that failed or are
+// missing are set back to new so the next run replicates the AIP again.
+func (a *App) VerifyReplicasA(ctx context.Context, params VerifyReplicasActivityParams) (*VerifyReplicasActivityResult, error) {
+	q := models.Aips.Query(models.SelectWhere.Aips.UUID.EQ(params.UUID))
+	q.Apply(models.SelectThenLoad.Aip.AipReplications())
+	aip, err := q.One(ctx, a.DB)
+	if err != nil {
+		return nil, err
+	}
+
+	pkg, err := a.StorageClient.Packages.GetByID(ctx, params.UUID)
+	if err != nil {
+		return nil, err
+	}
+
+	// Find the location of every replica to match them with the replication
+	// targets.
+	replicas, err := a.replicasByLocation(ctx, pkg)
+	if err != nil {
+		return nil, err
+	}
+
+	e := StartEvent(ActionVerifyReplicas)
+	result := &VerifyReplicasActivityResult{}
+	var failed []string
+	for _, r := range aip.R.AipReplications {
+		if r.Status != string(AIPReplicationStatusFinished) || r.FixityStatus.GetOrZero() == string(ReplicaFixityStatusPassed) {
+			continue
+		}
+		location := r.LocationUUID.GetOrZero()
+
+		setter := &models.AipReplicationSetter{}
+		status := ReplicaFixityStatusMissing
+		replica, ok := replicas[location]
+		// Check the replica recorded by ReplicateA when the location holds
+		// another one, e.g. an earlier replica that failed.
+		if id := r.ReplicaUUID.GetOrZero(); ok && id != "" && replica.UUID != id {
+			replica, err = a.StorageClient.Packages.GetByID(ctx, id)
+			if errors.Is(err, storage_service.ErrNotFound) {
+				ok = false
+			} else if err != nil {
+				return nil, err
+			}
+		}
+		if ok {
+			setter.ReplicaUUID = omitnull.From(replica.UUID)
+			stopHeartbeat := heartbeat(ctx)
+			res, err := a.StorageClient.Packages.CheckFixity(ctx, replica.UUID)
+			stopHeartbeat()
+			if err != nil {
+				return nil, err
+			}
+			status = ReplicaFixityStatusFailed
+			if res.Success {
+				status = ReplicaFixityStatusPassed
+			}
+		}
+		setter.FixityStatus = omitnull.From(string(status))
+		setter.FixityCheckedAt = omitnull.From(time.Now().Format(time.RFC3339))
+		if status != ReplicaFixityStatusPassed {
+			// The UUID of a failed replica is kept so that ReplicateA does not
+			// take it for an existing replica.
+			setter.Status = omit.From(string(AIPReplicationStatusNew))
+		}
+		if err := r.Update(ctx, a.DB, setter); err != nil {
+			return nil, err
+		}
+
+		detail := fmt.Sprintf("Replica in %s: %s", location, status)
+		if ok {
+			detail = fmt.Sprintf("Replica %s in %s: %s", replica.UUID, location, status)
+		}
+		e.AddDetail(detail)
+		result.Details = append(result.Details, detail)
+		if status != ReplicaFixityStatusPassed {
+			failed = append(failed, location)
+		}
+	}
+
+	if len(failed) > 0 {
+		msg := fmt.Sprintf("replica verification failed in %s", strings.Join(failed, ", "))
+		if err := EndEventErr(ctx, a, e, aip, msg); err != nil {
+			return nil, err
+		}
+		return nil, temporal.NewNonRetryableApplicationError(msg, VerificationFailedErrorType, nil)
+	}
+
+	if err := EndEventNoChange(ctx, a, e, aip); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// replicasByLocation fetches the replicas of the package, keyed by the UUID of
+// the location they are stored in. An uploaded replica is preferred to the
+// other ones of the same location.
+func (a *App) replicasByLocation(ctx context.Context, pkg *storage_service.Package) (map[string]*storage_service.Package, error) {
+	replicas := map[string]*storage_service.Package{}
+	for _, uri := range pkg.Replicas {
+		replica, err := a.StorageClient.Packages.GetByID(ctx, storage_service.ResourceUUID(uri))
+		if err != nil {
+			return nil, err
+		}
+		// A location can also list deleted replicas, the one stored there
+		// is kept.
+		location := storage_service.ResourceUUID(replica.CurrentLocation)
+		if prev, ok := replicas[location]; ok && prev.Status == "UPLOADED" {
+			continue
+		}
+		replicas[location] = replica
+	}
+	return replicas, nil
+}
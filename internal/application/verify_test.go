@@ -0,0 +1,158 @@
+package application
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"testing"
+
+	"github.com/aarondl/opt/omit"
+	"github.com/aarondl/opt/omitnull"
+	"go.temporal.io/sdk/activity"
+	"go.temporal.io/sdk/temporal"
+	"go.temporal.io/sdk/testsuite"
+	"gotest.tools/v3/assert"
+
+	"github.com/artefactual-labs/migrate/internal/database/gen/models"
+	"github.com/artefactual-labs/migrate/internal/storage_service"
+)
+
+func TestVerifyReplicasA(t *testing.T) {
+	t.Parallel()
+
+	const (
+		aipUUID     = "9d2c4b6a-1e3f-4a5b-8c7d-6e5f4a3b2c1d"
+		location    = "replica-location"
+		replicaUUID = "1a2b3c4d-5e6f-4a7b-8c9d-0e1f2a3b4c5d"
+		newReplica  = "5e6f7a8b-9c0d-4e1f-a2b3-c4d5e6f7a8b9"
+	)
+
+	// setup returns an activity environment running VerifyReplicasA and
+	// ReplicateA for an AIP replicated to location, against a Storage Service
+	// listing the replicas and answering the fixity checks with fixity.
+	setup := func(t *testing.T, replicas []string, fixity map[string]bool) (*App, *testsuite.TestActivityEnvironment) {
+		app := newTestApp(t)
+		aip, err := models.Aips.Insert(&models.AipSetter{
+			UUID:   omit.From(aipUUID),
+			Status: omit.From(string(AIPStatusReplicated)),
+		}).One(t.Context(), app.DB)
+		assert.NilError(t, err)
+		assert.NilError(t, aip.InsertAipReplications(t.Context(), app.DB, &models.AipReplicationSetter{
+			LocationUUID: omitnull.From(location),
+			Status:       omit.From(string(AIPReplicationStatusFinished)),
+			ReplicaUUID:  omitnull.From(replicaUUID),
+		}))
+
+		packages := map[string]storage_service.Package{
+			aipUUID:     {UUID: aipUUID, Status: "UPLOADED", Replicas: replicas},
+			replicaUUID: {UUID: replicaUUID, Status: "UPLOADED", CurrentLocation: "/api/v2/location/" + location + "/"},
+			newReplica:  {UUID: newReplica, Status: "UPLOADED", CurrentLocation: "/api/v2/location/" + location + "/"},
+		}
+		app.StorageClient = newTestStorageService(t, func(w http.ResponseWriter, r *http.Request) {
+			for id, pkg := range packages {
+				switch r.URL.Path {
+				case "/api/v2/file/" + id + "/":
+					_ = json.NewEncoder(w).Encode(pkg)
+					return
+				case "/api/v2/file/" + id + "/check_fixity/":
+					_ = json.NewEncoder(w).Encode(storage_service.FixityResponse{Success: fixity[id]})
+					return
+				}
+			}
+			http.NotFound(w, r)
+		})
+
+		var suite testsuite.WorkflowTestSuite
+		env := suite.NewTestActivityEnvironment()
+		env.RegisterActivityWithOptions(app.VerifyReplicasA, activity.RegisterOptions{Name: VerifyReplicasActivityName})
+		env.RegisterActivityWithOptions(app.ReplicateA, activity.RegisterOptions{Name: ReplicateAName})
+		return app, env
+	}
+
+	verify := func(t *testing.T, env *testsuite.TestActivityEnvironment) ([]string, error) {
+		t.Helper()
+		val, err := env.ExecuteActivity(VerifyReplicasActivityName, VerifyReplicasActivityParams{UUID: aipUUID})
+		if err != nil {
+			return nil, err
+		}
+		var res VerifyReplicasActivityResult
+		assert.NilError(t, val.Get(&res))
+		return res.Details, nil
+	}
+
+	replication := func(t *testing.T, app *App) *models.AipReplication {
+		t.Helper()
+		r, err := models.AipReplications.Query(
+			models.SelectWhere.AipReplications.LocationUUID.EQ(location),
+		).One(t.Context(), app.DB)
+		assert.NilError(t, err)
+		return r
+	}
+
+	assertFailed := func(t *testing.T, err error) {
+		t.Helper()
+		var appErr *temporal.ApplicationError
+		assert.Assert(t, errors.As(err, &appErr))
+		assert.Equal(t, appErr.Type(), VerificationFailedErrorType)
+		assert.Assert(t, appErr.NonRetryable())
+	}
+
+	t.Run("Passes intact replicas", func(t *testing.T) {
+		t.Parallel()
+
+		app, env := setup(t, []string{"/api/v2/file/" + replicaUUID + "/"}, map[string]bool{replicaUUID: true})
+		details, err := verify(t, env)
+		assert.NilError(t, err)
+		assert.DeepEqual(t, details, []string{"Replica " + replicaUUID + " in " + location + ": passed"})
+
+		r := replication(t, app)
+		assert.Equal(t, r.Status, string(AIPReplicationStatusFinished))
+		assert.Equal(t, r.FixityStatus.GetOrZero(), string(ReplicaFixityStatusPassed))
+	})
+
+	t.Run("Sets missing replicas back to new", func(t *testing.T) {
+		t.Parallel()
+
+		app, env := setup(t, nil, nil)
+		_, err := verify(t, env)
+		assertFailed(t, err)
+
+		r := replication(t, app)
+		assert.Equal(t, r.Status, string(AIPReplicationStatusNew))
+		assert.Equal(t, r.FixityStatus.GetOrZero(), string(ReplicaFixityStatusMissing))
+	})
+
+	t.Run("Replicates again the replicas that failed", func(t *testing.T) {
+		t.Parallel()
+
+		// The failed replica is still listed next to the new one.
+		replicas := []string{"/api/v2/file/" + replicaUUID + "/", "/api/v2/file/" + newReplica + "/"}
+		app, env := setup(t, replicas, map[string]bool{replicaUUID: false, newReplica: true})
+		_, err := verify(t, env)
+		assertFailed(t, err)
+
+		r := replication(t, app)
+		assert.Equal(t, r.Status, string(AIPReplicationStatusNew))
+		assert.Equal(t, r.FixityStatus.GetOrZero(), string(ReplicaFixityStatusFailed))
+		assert.Equal(t, r.ReplicaUUID.GetOrZero(), replicaUUID)
+
+		// The failed replica is not taken for an existing one.
+		output := "New replicas created for 1 of 1 AIPs in location " + location + "\nCreated replica " + newReplica
+		app.Config.StorageService.Management, _ = newTestManagement(t, output, 0)
+		val, err := env.ExecuteActivity(ReplicateAName, ReplicateParams{AipID: aipUUID, LocationUUID: "source-location", ReplicaLocationUUID: location})
+		assert.NilError(t, err)
+		var res ReplicateResult
+		assert.NilError(t, val.Get(&res))
+		assert.Equal(t, res.Existing, false)
+		assert.Equal(t, res.ReplicaUUID, newReplica)
+
+		r = replication(t, app)
+		assert.Equal(t, r.Status, string(AIPReplicationStatusFinished))
+		assert.Equal(t, r.FixityStatus.IsNull(), true)
+
+		// The new replica is the one checked.
+		details, err := verify(t, env)
+		assert.NilError(t, err)
+		assert.DeepEqual(t, details, []string{"Replica " + newReplica + " in " + location + ": passed"})
+	})
+}
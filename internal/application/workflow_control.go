@@ -0,0 +1,139 @@
+package application
+
+import (
+	"context"
+	"slices"
+	"time"
+
+	"github.com/aarondl/opt/omit"
+	"github.com/stephenafamo/bob/dialect/sqlite"
+	"github.com/stephenafamo/bob/dialect/sqlite/sm"
+	"github.com/stephenafamo/bob/dialect/sqlite/um"
+	"go.temporal.io/sdk/temporal"
+	"go.temporal.io/sdk/workflow"
+
+	"github.com/artefactual-labs/migrate/internal/database/gen/models"
+)
+
+// Signals accepted by the batch workflow, which forwards them to the per-AIP
+// workflows it is running.
+const (
+	PauseSignalName  = "pause"
+	ResumeSignalName = "resume"
+	CancelSignalName = "cancel"
+)
+
+// workflowControl tracks the pause, resume and cancel signals received by a
+// workflow.
+type workflowControl struct {
+	paused    bool
+	cancelled bool
+}
+
+// newWorkflowControl starts listening for control signals. onSignal, when not
+// nil, is called after each signal has been applied.
+func newWorkflowControl(ctx workflow.Context, paused bool, onSignal func(name string)) *workflowControl {
+	c := &workflowControl{paused: paused}
+
+	pauseCh := workflow.GetSignalChannel(ctx, PauseSignalName)
+	resumeCh := workflow.GetSignalChannel(ctx, ResumeSignalName)
+	cancelCh := workflow.GetSignalChannel(ctx, CancelSignalName)
+
+	handle := func(name string, apply func()) func(workflow.ReceiveChannel, bool) {
+		return func(ch workflow.ReceiveChannel, _ bool) {
+			ch.Receive(ctx, nil)
+			apply()
+			workflow.GetLogger(ctx).Info("Received signal.", "signal", name)
+			if onSignal != nil {
+				onSignal(name)
+			}
+		}
+	}
+
+	workflow.Go(ctx, func(ctx workflow.Context) {
+		selector := workflow.NewSelector(ctx)
+		selector.AddReceive(pauseCh, handle(PauseSignalName, func() { c.paused = true }))
+		selector.AddReceive(resumeCh, handle(ResumeSignalName, func() { c.paused = false }))
+		selector.AddReceive(cancelCh, handle(CancelSignalName, func() { c.cancelled = true }))
+		for {
+			selector.Select(ctx)
+		}
+	})
+
+	return c
+}
+
+// checkpoint marks a step boundary: it blocks while the workflow is paused and
+// reports whether it has been cancelled.
+func (c *workflowControl) checkpoint(ctx workflow.Context) (bool, error) {
+	if err := workflow.Await(ctx, func() bool { return !c.paused || c.cancelled }); err != nil {
+		return false, err
+	}
+	return c.cancelled, nil
+}
+
+type CancelAIPsParams struct {
+	UUIDs []string
+
+	// BatchID, when set, also cancels the AIPs stored for the batch
+	// workflow from position Offset on.
+	BatchID string
+	Offset  int
+}
+
+const CancelAIPsName = "cancel-aips"
+
+// CancelAIPs records the cancelled status for the given AIPs. AIPs with an
+// outcome, e.g. moved or failed, keep it.
+func (a *App) CancelAIPs(ctx context.Context, params CancelAIPsParams) error {
+	setter := &models.AipSetter{Status: omit.From(string(AIPStatusCancelled))}
+	var outcomes []string
+	for _, s := range slices.Concat(doneStatuses, skippedStatuses, failedStatuses) {
+		outcomes = append(outcomes, string(s))
+	}
+	unfinished := models.UpdateWhere.Aips.Status.NotIn(outcomes...)
+	if len(params.UUIDs) > 0 {
+		if _, err := models.Aips.Update(
+			setter.UpdateMod(),
+			models.UpdateWhere.Aips.UUID.In(params.UUIDs...),
+			unfinished,
+		).Exec(ctx, a.DB); err != nil {
+			return err
+		}
+	}
+	if params.BatchID != "" {
+		if _, err := models.Aips.Update(
+			setter.UpdateMod(),
+			um.Where(models.Aips.Columns.UUID.In(sqlite.Select(
+				sm.Columns(models.BatchWorkflowAips.Columns.AipUUID),
+				sm.From(models.BatchWorkflowAips.Name()),
+				sm.Where(models.BatchWorkflowAips.Columns.BatchID.EQ(sqlite.Arg(params.BatchID))),
+				sm.Where(models.BatchWorkflowAips.Columns.Position.GTE(sqlite.Arg(params.Offset))),
+			))),
+			unfinished,
+		).Exec(ctx, a.DB); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func cancelAIPs(ctx workflow.Context, uuids ...string) error {
+	return executeCancelAIPs(ctx, CancelAIPsParams{UUIDs: uuids})
+}
+
+// cancelBatchAIPs cancels the AIPs of the batch workflow from position offset
+// on.
+func cancelBatchAIPs(ctx workflow.Context, batchID string, offset int) error {
+	return executeCancelAIPs(ctx, CancelAIPsParams{BatchID: batchID, Offset: offset})
+}
+
+func executeCancelAIPs(ctx workflow.Context, params CancelAIPsParams) error {
+	ctx = workflow.WithActivityOptions(ctx, workflow.ActivityOptions{
+		StartToCloseTimeout: time.Minute,
+		RetryPolicy: &temporal.RetryPolicy{
+			MaximumAttempts: 5,
+		},
+	})
+	return workflow.ExecuteActivity(ctx, CancelAIPsName, params).Get(ctx, nil)
+}
@@ -0,0 +1,122 @@
+package application
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/aarondl/opt/omit"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/mock"
+	"go.temporal.io/sdk/activity"
+	"go.temporal.io/sdk/testsuite"
+	"go.temporal.io/sdk/workflow"
+	"gotest.tools/v3/assert"
+
+	"github.com/artefactual-labs/migrate/internal/database/gen/models"
+)
+
+func TestWorkflowControl(t *testing.T) {
+	t.Parallel()
+
+	aipUUID := uuid.MustParse("0d4f9c61-52b3-4c3e-8a8c-3f2b7f5a1e21")
+
+	// setup returns a move workflow environment whose Storage Service
+	// activities are mocked, and the time at which the AIP was looked up, which
+	// is the first step after the first checkpoint.
+	setup := func(t *testing.T) (*App, *testsuite.TestWorkflowEnvironment, *time.Time) {
+		app := newTestApp(t)
+		_, err := models.Aips.Insert(&models.AipSetter{
+			UUID:   omit.From(aipUUID.String()),
+			Status: omit.From(string(AIPStatusNew)),
+		}).Exec(t.Context(), app.DB)
+		assert.NilError(t, err)
+
+		var suite testsuite.WorkflowTestSuite
+		env := suite.NewTestWorkflowEnvironment()
+		env.RegisterWorkflowWithOptions(NewMoveWorkflow(app).Run, workflow.RegisterOptions{Name: MoveWorkflowName})
+		for name, fn := range map[string]any{
+			InitAIPInDatabaseName:                     app.InitAIPInDatabase,
+			CheckStorageServiceConnectionActivityName: NewCheckStorageServiceConnectionActivity(nil).Execute,
+			FindAName:             app.FindA,
+			StartMoveActivityName: app.StartMoveA,
+			CancelAIPsName:        app.CancelAIPs,
+		} {
+			env.RegisterActivityWithOptions(fn, activity.RegisterOptions{Name: name})
+		}
+
+		env.OnActivity(InitAIPInDatabaseName, mock.Anything, mock.Anything, mock.Anything).Return(&InitAIPInDatabaseResult{Status: string(AIPStatusNew)}, nil)
+		env.OnActivity(CheckStorageServiceConnectionActivityName, mock.Anything, mock.Anything).Return(nil)
+		env.OnActivity(StartMoveActivityName, mock.Anything, mock.Anything).Return(&StartMoveActivityResult{Status: string(AIPStatusMoved), Done: true}, nil).Maybe()
+
+		var foundAt time.Time
+		env.OnActivity(FindAName, mock.Anything, mock.Anything).Return(func(context.Context, FindParams) (*FindResult, error) {
+			foundAt = env.Now()
+			return &FindResult{Status: string(AIPStatusFound)}, nil
+		}).Maybe()
+
+		return app, env, &foundAt
+	}
+
+	signalAt := func(env *testsuite.TestWorkflowEnvironment, name string, d time.Duration) {
+		env.RegisterDelayedCallback(func() { env.SignalWorkflow(name, nil) }, d)
+	}
+
+	t.Run("Waits while paused and carries on when resumed", func(t *testing.T) {
+		t.Parallel()
+
+		app, env, foundAt := setup(t)
+		start := env.Now()
+		signalAt(env, PauseSignalName, 0)
+		signalAt(env, ResumeSignalName, time.Hour)
+
+		env.ExecuteWorkflow(MoveWorkflowName, MoveWorkflowParams{UUID: aipUUID})
+		assert.Assert(t, env.IsWorkflowCompleted())
+		assert.NilError(t, env.GetWorkflowError())
+
+		var result MoveWorkflowResult
+		assert.NilError(t, env.GetWorkflowResult(&result))
+		assert.Equal(t, result.Cancelled, false)
+		assert.Equal(t, result.Message, "Status: moved")
+		assert.Assert(t, !foundAt.Before(start.Add(time.Hour)), "looked up at %s, before the resume signal", foundAt)
+		env.AssertActivityNotCalled(t, CancelAIPsName, mock.Anything, mock.Anything)
+		assert.Equal(t, getTestAIP(t, app, aipUUID.String()).Status, string(AIPStatusNew))
+	})
+
+	t.Run("Stops at the next checkpoint when cancelled", func(t *testing.T) {
+		t.Parallel()
+
+		app, env, _ := setup(t)
+		signalAt(env, CancelSignalName, 0)
+
+		env.ExecuteWorkflow(MoveWorkflowName, MoveWorkflowParams{UUID: aipUUID})
+		assert.Assert(t, env.IsWorkflowCompleted())
+		assert.NilError(t, env.GetWorkflowError())
+
+		var result MoveWorkflowResult
+		assert.NilError(t, env.GetWorkflowResult(&result))
+		assert.Equal(t, result.Cancelled, true)
+		assert.Equal(t, result.Message, "Cancelled")
+		env.AssertActivityNotCalled(t, FindAName, mock.Anything, mock.Anything)
+		env.AssertActivityNotCalled(t, StartMoveActivityName, mock.Anything, mock.Anything)
+		assert.Equal(t, getTestAIP(t, app, aipUUID.String()).Status, string(AIPStatusCancelled))
+	})
+
+	t.Run("Cancels a paused workflow", func(t *testing.T) {
+		t.Parallel()
+
+		app, env, _ := setup(t)
+		signalAt(env, PauseSignalName, 0)
+		signalAt(env, CancelSignalName, time.Hour)
+
+		env.ExecuteWorkflow(MoveWorkflowName, MoveWorkflowParams{UUID: aipUUID})
+		assert.Assert(t, env.IsWorkflowCompleted())
+		assert.NilError(t, env.GetWorkflowError())
+
+		var result MoveWorkflowResult
+		assert.NilError(t, env.GetWorkflowResult(&result))
+		assert.Equal(t, result.Cancelled, true)
+		env.AssertActivityNotCalled(t, FindAName, mock.Anything, mock.Anything)
+		assert.Equal(t, getTestAIP(t, app, aipUUID.String()).Status, string(AIPStatusCancelled))
+	})
+}
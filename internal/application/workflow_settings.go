@@ -0,0 +1,66 @@
+package application
+
+// Change IDs of the workflow.GetVersion markers. A marker guards each change
+// that makes a workflow issue different commands, so runs started by an
+// earlier build keep replaying the code path they were recorded with.
+const (
+	movePollingChangeID       = "move-polling"
+	locationLeasesChangeID    = "location-leases"
+	maintenanceWindowChangeID = "maintenance-window"
+	verifyAfterMoveChangeID   = "verify-after-move"
+	replicaFixityChangeID     = "replica-fixity"
+	cleanupChangeID           = "cleanup"
+	reindexChangeID           = "reindex"
+	reindexMovedChangeID      = "reindex-moved"
+)
+
+// WorkflowSettings is the configuration used by the move and replicate
+// workflows. It is read from config.json when a batch is submitted and passed
+// in the workflow parameters, so a run does not change course when config.json
+// is edited while it is in flight.
+type WorkflowSettings struct {
+	Locations StorageServiceLocationConfig `json:"locations"`
+	Move      WorkflowMoveConfig           `json:"move"`
+	Replicate WorkflowReplicateConfig      `json:"replicate"`
+	Pipeline  WorkflowPipelineConfig       `json:"pipeline"`
+	Cleanup   WorkflowCleanupConfig        `json:"cleanup"`
+	Schedule  ScheduleConfig               `json:"schedule"`
+
+	// Management is where the Storage Service management commands run. It is
+	// nil for runs submitted before it was part of the settings.
+	Management *StorageServiceManagementConfig `json:"management,omitempty"`
+}
+
+// WorkflowSettings returns the settings passed to the workflows submitted with
+// this configuration.
+func (c *Config) WorkflowSettings() *WorkflowSettings {
+	management := c.StorageService.Management
+	return &WorkflowSettings{
+		Locations:  c.StorageService.Locations,
+		Move:       c.Workflows.Move,
+		Replicate:  c.Workflows.Replicate,
+		Pipeline:   c.Workflows.Pipeline,
+		Cleanup:    c.Workflows.Cleanup,
+		Schedule:   c.Schedule,
+		Management: &management,
+	}
+}
+
+// workflowSettings returns the settings passed in the workflow parameters.
+// Runs submitted before the settings were part of the parameters fall back to
+// the configuration of the worker, which is what they used at the time.
+func (a *App) workflowSettings(s *WorkflowSettings) *WorkflowSettings {
+	if s != nil {
+		return s
+	}
+	return a.Config.WorkflowSettings()
+}
+
+// management returns where the management commands of the run go, falling
+// back to the configuration of the worker like workflowSettings.
+func (a *App) management(s *WorkflowSettings) StorageServiceManagementConfig {
+	if s != nil && s.Management != nil {
+		return *s.Management
+	}
+	return a.Config.StorageService.Management
+}
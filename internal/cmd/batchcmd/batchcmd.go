@@ -0,0 +1,69 @@
+package batchcmd
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/peterbourgon/ff/v4"
+
+	"github.com/artefactual-labs/migrate/internal/application"
+	"github.com/artefactual-labs/migrate/internal/cmd/rootcmd"
+)
+
+type Config struct {
+	*rootcmd.RootConfig
+	Command *ff.Command
+	Flags   *ff.FlagSet
+}
+
+func New(parent *rootcmd.RootConfig) *Config {
+	cfg := &Config{RootConfig: parent}
+	cfg.Flags = ff.NewFlagSet("batch").SetParent(parent.Flags)
+
+	cfg.Command = &ff.Command{
+		Name:      "batch",
+		Usage:     "migrate batch <pause|resume|cancel> BATCH_ID",
+		ShortHelp: "Control a running batch of moves or replications.",
+		Flags:     cfg.Flags,
+	}
+
+	cfg.addSignalCommand("pause", application.PauseSignalName, "Pause the batch; running AIPs stop at their next step.")
+	cfg.addSignalCommand("resume", application.ResumeSignalName, "Resume a paused batch.")
+	cfg.addSignalCommand("cancel", application.CancelSignalName, "Cancel the batch; AIPs not finished are marked as cancelled.")
+
+	parent.Command.Subcommands = append(parent.Command.Subcommands, cfg.Command)
+	return cfg
+}
+
+func (cfg *Config) addSignalCommand(name, signal, help string) {
+	flags := ff.NewFlagSet(name).SetParent(cfg.Flags)
+	cfg.Command.Subcommands = append(cfg.Command.Subcommands, &ff.Command{
+		Name:      name,
+		Usage:     fmt.Sprintf("migrate batch %s BATCH_ID", name),
+		ShortHelp: help,
+		Flags:     flags,
+		Exec: func(ctx context.Context, args []string) error {
+			return cfg.signal(ctx, signal, args)
+		},
+	})
+}
+
+func (cfg *Config) signal(ctx context.Context, signal string, args []string) error {
+	if len(args) != 1 {
+		return errors.New("expected exactly one batch ID")
+	}
+	batchID := args[0]
+
+	app, err := cfg.App(ctx)
+	if err != nil {
+		return err
+	}
+
+	if err := app.SignalBatch(ctx, batchID, signal); err != nil {
+		return fmt.Errorf("signal batch workflow: %w", err)
+	}
+	cfg.Logger().Info("Signal sent.", "batch_id", batchID, "signal", signal)
+
+	return nil
+}
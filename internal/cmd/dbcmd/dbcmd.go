@@ -0,0 +1,153 @@
+package dbcmd
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+	"text/tabwriter"
+	"time"
+
+	"github.com/peterbourgon/ff/v4"
+
+	"github.com/artefactual-labs/migrate/internal/cmd/rootcmd"
+	"github.com/artefactual-labs/migrate/internal/database/migrations"
+)
+
+type Config struct {
+	*rootcmd.RootConfig
+	Command *ff.Command
+	Flags   *ff.FlagSet
+
+	backup   string
+	noBackup bool
+}
+
+func New(parent *rootcmd.RootConfig) *Config {
+	cfg := &Config{RootConfig: parent}
+	cfg.Flags = ff.NewFlagSet("db").SetParent(parent.Flags)
+
+	cfg.Command = &ff.Command{
+		Name:      "db",
+		Usage:     "migrate db <migrate|status>",
+		ShortHelp: "Manage the schema of the database.",
+		Flags:     cfg.Flags,
+	}
+
+	migrateFlags := ff.NewFlagSet("migrate").SetParent(cfg.Flags)
+	migrateFlags.StringVar(&cfg.backup, 0, "backup", "", "Path of the backup written before applying migrations (defaults to the database path with the version and time appended).")
+	migrateFlags.BoolVar(&cfg.noBackup, 0, "no-backup", "Apply migrations without backing up the database first.")
+	cfg.Command.Subcommands = append(cfg.Command.Subcommands,
+		&ff.Command{
+			Name:      "migrate",
+			Usage:     "migrate db migrate [FLAGS]",
+			ShortHelp: "Back up the database and apply the pending migrations.",
+			Flags:     migrateFlags,
+			Exec:      cfg.migrate,
+		},
+		&ff.Command{
+			Name:      "status",
+			Usage:     "migrate db status",
+			ShortHelp: "Show the schema version and the pending migrations.",
+			Flags:     ff.NewFlagSet("status").SetParent(cfg.Flags),
+			Exec:      cfg.status,
+		},
+	)
+
+	parent.Command.Subcommands = append(parent.Command.Subcommands, cfg.Command)
+	return cfg
+}
+
+func (cfg *Config) migrate(ctx context.Context, _ []string) (err error) {
+	if cfg.backup != "" && cfg.noBackup {
+		return errors.New("--backup and --no-backup cannot be used together")
+	}
+
+	db, path, err := cfg.Database(ctx)
+	if err != nil {
+		return err
+	}
+	defer func() { err = errors.Join(err, db.Close()) }()
+
+	status, err := migrations.GetStatus(ctx, db)
+	if err != nil {
+		return err
+	}
+	logger := cfg.Logger()
+	if len(status.Pending) == 0 {
+		logger.Info("Database schema is up to date.", "version", status.Version)
+		return nil
+	}
+
+	if !status.Empty && !cfg.noBackup {
+		backup := cfg.backup
+		if backup == "" {
+			backup = fmt.Sprintf("%s.v%d-%s.bak", path, status.Version, time.Now().UTC().Format("20060102T150405Z"))
+		}
+		if err := migrations.Backup(ctx, db, backup); err != nil {
+			return err
+		}
+		logger.Info("Database backed up.", "path", backup)
+	}
+
+	applied, err := migrations.Migrate(ctx, db)
+	for _, m := range applied {
+		logger.Info("Migration applied.", "version", m.Version, "name", m.Name)
+	}
+	if err != nil {
+		return err
+	}
+	logger.Info("Database schema is up to date.", "version", status.Latest())
+
+	return nil
+}
+
+func (cfg *Config) status(ctx context.Context, _ []string) (err error) {
+	db, path, err := cfg.Database(ctx)
+	if err != nil {
+		return err
+	}
+	defer func() { err = errors.Join(err, db.Close()) }()
+
+	status, err := migrations.GetStatus(ctx, db)
+	if err != nil {
+		return err
+	}
+
+	return writeStatus(cfg.Stdout, path, status)
+}
+
+func writeStatus(w io.Writer, path string, s *migrations.Status) error {
+	var b strings.Builder
+
+	fmt.Fprintf(&b, "Database\t%s\n", path)
+	switch {
+	case s.Empty:
+		fmt.Fprintf(&b, "Version\tnone, the database is empty\n")
+	case s.Legacy:
+		fmt.Fprintf(&b, "Version\tnone, created before versioned migrations\n")
+	default:
+		fmt.Fprintf(&b, "Version\t%d\n", s.Version)
+	}
+	fmt.Fprintf(&b, "Latest\t%d\n", s.Latest())
+
+	if len(s.Applied) > 0 {
+		b.WriteString("\nApplied\t\n")
+		for _, m := range s.Applied {
+			fmt.Fprintf(&b, "  %04d\t%s\t%s\n", m.Version, m.Name, m.AppliedAt.Format(time.DateTime))
+		}
+	}
+	if len(s.Pending) > 0 {
+		b.WriteString("\nPending\t\n")
+		for _, m := range s.Pending {
+			fmt.Fprintf(&b, "  %04d\t%s\t\n", m.Version, m.Name)
+		}
+	}
+
+	tw := tabwriter.NewWriter(w, 0, 0, 2, ' ', 0)
+	if _, err := io.WriteString(tw, b.String()); err != nil {
+		return err
+	}
+	return tw.Flush()
+}
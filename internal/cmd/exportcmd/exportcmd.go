@@ -1,29 +1,38 @@
 package exportcmd
 
 import (
+	"cmp"
 	"context"
 	"errors"
 	"fmt"
+	"io"
 	"strings"
 
 	"github.com/peterbourgon/ff/v4"
 
+	"github.com/artefactual-labs/migrate/internal/application"
 	"github.com/artefactual-labs/migrate/internal/cmd/rootcmd"
+	"github.com/artefactual-labs/migrate/internal/database/gen/models"
 )
 
 type Config struct {
 	*rootcmd.RootConfig
 	Command *ff.Command
 	Flags   *ff.FlagSet
+
+	output string
+	batch  string
 }
 
 func New(parent *rootcmd.RootConfig) *Config {
 	cfg := &Config{RootConfig: parent}
 	cfg.Flags = ff.NewFlagSet("export").SetParent(parent.Flags)
+	cfg.Flags.StringVar(&cfg.output, 0, "output", "", "File the report is written to, or - for stdout (defaults to move-report.csv or replication-report.csv).")
+	cfg.Flags.StringVar(&cfg.batch, 0, "batch", "", "Only report the AIPs of this batch.")
 
 	cfg.Command = &ff.Command{
 		Name:      "export",
-		Usage:     "migrate export <TYPE>",
+		Usage:     "migrate export [FLAGS] <TYPE>",
 		ShortHelp: "Export reports about the migrate workflows.",
 		Flags:     cfg.Flags,
 		Exec:      cfg.Exec,
@@ -43,13 +52,23 @@ func (cfg *Config) Exec(ctx context.Context, args []string) error {
 		return err
 	}
 
+	var batch *models.Batch
+	if cfg.batch != "" {
+		if batch, err = app.GetBatch(ctx, cfg.batch); err != nil {
+			return err
+		}
+	}
+
 	switch strings.ToLower(args[0]) {
 	case "move":
-		if err := app.ExportMove(ctx); err != nil {
+		output := cmp.Or(cfg.output, application.MoveReportFile)
+		if err := cfg.WriteOutput(output, func(w io.Writer) error { return app.ExportMove(ctx, w, batch) }); err != nil {
 			return fmt.Errorf("export move report: %w", err)
 		}
+		cfg.Logger().Info("Move export generated", "path", output)
 	case "replicate":
-		if err := app.ExportReplication(ctx); err != nil {
+		output := cmp.Or(cfg.output, application.ReplicationReportFile)
+		if err := cfg.WriteOutput(output, func(w io.Writer) error { return app.ExportReplication(ctx, w, batch) }); err != nil {
 			return fmt.Errorf("export replication report: %w", err)
 		}
 	default:
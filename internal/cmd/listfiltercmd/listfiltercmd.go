@@ -2,6 +2,7 @@ package listfiltercmd
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"io"
 
@@ -15,15 +16,22 @@ type Config struct {
 	*rootcmd.RootConfig
 	Command *ff.Command
 	Flags   *ff.FlagSet
+
+	input  string
+	filter string
+	output string
 }
 
 func New(parent *rootcmd.RootConfig) *Config {
 	cfg := &Config{RootConfig: parent}
 	cfg.Flags = ff.NewFlagSet("list-filter").SetParent(parent.Flags)
+	cfg.Flags.StringVar(&cfg.input, 0, "input", "original_list.txt", "File listing the UUIDs to filter, or - to read them from stdin.")
+	cfg.Flags.StringVar(&cfg.filter, 0, "filter", "to_filter_out.txt", "File listing the UUIDs to filter out, or - to read them from stdin.")
+	cfg.Flags.StringVar(&cfg.output, 0, "output", "final_list.txt", "File the remaining UUIDs are written to, or - for stdout.")
 
 	cfg.Command = &ff.Command{
 		Name:      "list-filter",
-		Usage:     "migrate list-filter",
+		Usage:     "migrate list-filter [FLAGS]",
 		ShortHelp: "Filter UUIDs from original_list.txt based on to_filter_out.txt.",
 		Flags:     cfg.Flags,
 		Exec:      cfg.Exec,
@@ -34,22 +42,18 @@ func New(parent *rootcmd.RootConfig) *Config {
 }
 
 func (cfg *Config) Exec(ctx context.Context, _ []string) error {
-	filterList, err := application.ReadNonEmptyLines("to_filter_out.txt")
-	if err != nil {
-		return fmt.Errorf("read to_filter_out.txt: %w", err)
-	}
-
-	if _, err := application.ValidateUUIDs(filterList); err != nil {
-		return fmt.Errorf("validate to_filter_out.txt: %w", err)
+	if cfg.input == rootcmd.Stdio && cfg.filter == rootcmd.Stdio {
+		return errors.New("--input and --filter cannot both read from stdin")
 	}
 
-	originalList, err := application.ReadNonEmptyLines("original_list.txt")
+	filterList, err := cfg.readList(cfg.filter)
 	if err != nil {
-		return fmt.Errorf("read original_list.txt: %w", err)
+		return err
 	}
 
-	if _, err := application.ValidateUUIDs(originalList); err != nil {
-		return fmt.Errorf("validate original_list.txt: %w", err)
+	originalList, err := cfg.readList(cfg.input)
+	if err != nil {
+		return err
 	}
 
 	filterSet := make(map[string]struct{}, len(filterList))
@@ -65,17 +69,40 @@ func (cfg *Config) Exec(ctx context.Context, _ []string) error {
 		finalList = append(finalList, v)
 	}
 
-	if err := application.WriteLines("final_list.txt", finalList); err != nil {
-		return fmt.Errorf("write final_list.txt: %w", err)
+	if err := cfg.WriteOutput(cfg.output, func(w io.Writer) error { return application.WriteLines(w, finalList) }); err != nil {
+		return fmt.Errorf("write %s: %w", cfg.output, err)
 	}
 
-	printf(cfg.Stdout, "Original Count: %d\n", len(originalList))
-	printf(cfg.Stdout, "To Filter Count: %d\n", len(filterList))
-	printf(cfg.Stdout, "Final Count: %d\n", len(finalList))
+	// Keep stdout for the list when it is written there.
+	out := cfg.Stdout
+	if cfg.output == rootcmd.Stdio {
+		out = cfg.Stderr
+	}
+	printf(out, "Original Count: %d\n", len(originalList))
+	printf(out, "To Filter Count: %d\n", len(filterList))
+	printf(out, "Final Count: %d\n", len(finalList))
 
 	return nil
 }
 
+// readList reads and validates the UUIDs listed in the file at path.
+func (cfg *Config) readList(path string) ([]string, error) {
+	r, err := cfg.OpenInput(path)
+	if err != nil {
+		return nil, fmt.Errorf("read %s: %w", path, err)
+	}
+	defer r.Close() //nolint:errcheck
+
+	list, err := application.ReadNonEmptyLines(r)
+	if err != nil {
+		return nil, fmt.Errorf("read %s: %w", path, err)
+	}
+	if _, err := application.ValidateUUIDs(list); err != nil {
+		return nil, fmt.Errorf("validate %s: %w", path, err)
+	}
+	return list, nil
+}
+
 func printf(w io.Writer, format string, args ...any) {
 	_, _ = fmt.Fprintf(w, format, args...)
 }
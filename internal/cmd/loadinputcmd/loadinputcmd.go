@@ -2,27 +2,44 @@ package loadinputcmd
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"io"
 
+	"github.com/google/uuid"
 	"github.com/peterbourgon/ff/v4"
 
 	"github.com/artefactual-labs/migrate/internal/application"
 	"github.com/artefactual-labs/migrate/internal/cmd/rootcmd"
+	"github.com/artefactual-labs/migrate/internal/database/gen/models"
 )
 
 type Config struct {
 	*rootcmd.RootConfig
 	Command *ff.Command
 	Flags   *ff.FlagSet
+
+	priority     int64
+	fromLocation string
+	saveInput    string
+	input        string
+	output       string
+	batch        string
 }
 
 func New(parent *rootcmd.RootConfig) *Config {
 	cfg := &Config{RootConfig: parent}
 	cfg.Flags = ff.NewFlagSet("load-input").SetParent(parent.Flags)
+	cfg.Flags.Int64Var(&cfg.priority, 0, "priority", 0, "Priority recorded for the loaded AIPs, used with the priority order (higher goes first).")
+	cfg.Flags.StringVar(&cfg.fromLocation, 0, "from-location", "", "Load the AIPs stored in this Storage Service location instead of reading the input file.")
+	cfg.Flags.StringVar(&cfg.saveInput, 0, "save-input", "", "With --from-location, also write the UUIDs of the loaded AIPs to this file, e.g. input.txt, or - for stdout.")
+	cfg.Flags.StringVar(&cfg.input, 0, "input", application.InputFile, "File listing the AIPs, or - to read them from stdin.")
+	cfg.Flags.StringVar(&cfg.output, 0, "output", application.ReplicationReportFile, "File the replication report is written to, or - for stdout.")
+	cfg.Flags.StringVar(&cfg.batch, 0, "batch", "", "Name of the batch the loaded AIPs are added to, created if needed; the report only lists the AIPs of the batch.")
 
 	cfg.Command = &ff.Command{
 		Name:      "load-input",
-		Usage:     "migrate load-input",
+		Usage:     "migrate load-input [FLAGS]",
 		ShortHelp: "Populate the database with input UUIDs and export replication data.",
 		Flags:     cfg.Flags,
 		Exec:      cfg.Exec,
@@ -33,28 +50,88 @@ func New(parent *rootcmd.RootConfig) *Config {
 }
 
 func (cfg *Config) Exec(ctx context.Context, _ []string) error {
+	if cfg.saveInput != "" && cfg.fromLocation == "" {
+		return errors.New("--save-input requires --from-location")
+	}
+	if cfg.saveInput == rootcmd.Stdio && cfg.output == rootcmd.Stdio {
+		return errors.New("--save-input and --output cannot both write to stdout")
+	}
+
 	app, err := cfg.App(ctx)
 	if err != nil {
 		return err
 	}
 
-	uuids, err := application.LoadInputUUIDs()
-	if err != nil {
-		return err
+	setPriority := false
+	if f, ok := cfg.Flags.GetFlag("priority"); ok {
+		setPriority = f.IsSet()
 	}
 
-	for _, id := range uuids {
-		if _, err := app.InitAIPInDatabase(ctx, id); err != nil {
-			return fmt.Errorf("init AIP in database: %w", err)
+	var (
+		uuids []uuid.UUID
+		input *application.Input
+	)
+	if cfg.fromLocation != "" {
+		uuids, err = app.LoadLocation(ctx, cfg.fromLocation)
+		if err != nil {
+			return err
+		}
+		if setPriority {
+			for _, id := range uuids {
+				if err := app.SetAIPPriority(ctx, id.String(), cfg.priority); err != nil {
+					return fmt.Errorf("set AIP priority: %w", err)
+				}
+			}
+		}
+		if cfg.saveInput != "" {
+			lines := make([]string, len(uuids))
+			for i, id := range uuids {
+				lines[i] = id.String()
+			}
+			if err := cfg.WriteOutput(cfg.saveInput, func(w io.Writer) error { return application.WriteLines(w, lines) }); err != nil {
+				return fmt.Errorf("save input: %w", err)
+			}
 		}
-		if _, err := app.FindA(ctx, application.FindParams{AipID: id.String()}); err != nil {
-			return fmt.Errorf("find AIP: %w", err)
+	} else {
+		input, err = cfg.LoadInput(ctx, cfg.input)
+		if err != nil {
+			return err
+		}
+		uuids = input.UUIDs()
+		for _, row := range input.Rows {
+			id := row.UUID
+			if _, err := app.InitAIPInDatabase(ctx, id, nil); err != nil {
+				return fmt.Errorf("init AIP in database: %w", err)
+			}
+			// A priority in the input file wins over the flag.
+			if setPriority && row.Priority == nil {
+				if err := app.SetAIPPriority(ctx, id.String(), cfg.priority); err != nil {
+					return fmt.Errorf("set AIP priority: %w", err)
+				}
+			}
+			if _, err := app.FindA(ctx, application.FindParams{AipID: id.String()}); err != nil {
+				return fmt.Errorf("find AIP: %w", err)
+			}
 		}
 	}
 
-	if err := app.ExportReplication(ctx); err != nil {
+	var batch *models.Batch
+	if cfg.batch != "" {
+		if batch, err = app.EnsureBatch(ctx, cfg.batch, "", input); err != nil {
+			return err
+		}
+		if err := app.AddBatchAIPs(ctx, batch, uuids); err != nil {
+			return fmt.Errorf("add AIPs to batch: %w", err)
+		}
+	}
+
+	if err := cfg.WriteOutput(cfg.output, func(w io.Writer) error { return app.ExportReplication(ctx, w, batch) }); err != nil {
 		return fmt.Errorf("export replication: %w", err)
 	}
 
+	if input != nil && len(input.Errors) > 0 {
+		return fmt.Errorf("skipped %d malformed input rows", len(input.Errors))
+	}
+
 	return nil
 }
@@ -5,12 +5,9 @@ import (
 	"database/sql"
 	"errors"
 	"fmt"
-	"time"
 
+	"github.com/google/uuid"
 	"github.com/peterbourgon/ff/v4"
-	"go.temporal.io/api/enums/v1"
-	"go.temporal.io/api/serviceerror"
-	"go.temporal.io/sdk/client"
 
 	"github.com/artefactual-labs/migrate/internal/application"
 	"github.com/artefactual-labs/migrate/internal/cmd/rootcmd"
@@ -20,16 +17,33 @@ type Config struct {
 	*rootcmd.RootConfig
 	Command *ff.Command
 	Flags   *ff.FlagSet
+
+	wait          bool
+	maxConcurrent int
+	order         string
+	dryRun        bool
+	plan          int64
+	input         string
+	output        string
+	batch         string
 }
 
 func New(parent *rootcmd.RootConfig) *Config {
 	cfg := &Config{RootConfig: parent}
 	cfg.Flags = ff.NewFlagSet("move").SetParent(parent.Flags)
+	cfg.Flags.BoolVar(&cfg.wait, 0, "wait", "Wait for the batch to finish before returning.")
+	cfg.Flags.IntVar(&cfg.maxConcurrent, 0, "max-concurrent", 0, "Maximum number of AIPs processed at the same time (defaults to workflows.batch.max_concurrent).")
+	cfg.Flags.StringVar(&cfg.order, 0, "order", "", "Submission order: input, largest-first, smallest-first or priority (defaults to workflows.batch.order).")
+	cfg.Flags.BoolVar(&cfg.dryRun, 0, "dry-run", "Report and store what would be done without submitting anything.")
+	cfg.Flags.Int64Var(&cfg.plan, 0, "plan", 0, "ID of a plan stored with --dry-run that the AIPs must still match.")
+	cfg.Flags.StringVar(&cfg.input, 0, "input", "", "File listing the AIPs, or - to read them from stdin (defaults to input.txt, or the AIPs of --batch).")
+	cfg.Flags.StringVar(&cfg.batch, 0, "batch", "", "Name of the batch the AIPs belong to; without --input, process the AIPs of the batch.")
+	cfg.Flags.StringVar(&cfg.output, 0, "output", rootcmd.Stdio, "File the --dry-run plan is written to, or - for stdout.")
 
 	cfg.Command = &ff.Command{
 		Name:      "move",
-		Usage:     "migrate move",
-		ShortHelp: "Move AIPs listed in input.txt via Temporal workflows.",
+		Usage:     "migrate move [FLAGS]",
+		ShortHelp: "Move AIPs listed in the input file via Temporal workflows.",
 		Flags:     cfg.Flags,
 		Exec:      cfg.Exec,
 	}
@@ -44,67 +58,80 @@ func (cfg *Config) Exec(ctx context.Context, _ []string) error {
 		return err
 	}
 
-	uuids, err := application.LoadInputUUIDs()
+	uuids, err := cfg.LoadAIPs(ctx, application.BatchOperationMove, cfg.input, cfg.batch, cfg.dryRun)
 	if err != nil {
 		return err
 	}
 
+	if cfg.dryRun {
+		plan, err := app.Plan(ctx, application.BatchOperationMove, uuids)
+		if err != nil {
+			return err
+		}
+		if err := app.SavePlan(ctx, plan); err != nil {
+			return err
+		}
+		return cfg.WriteOutput(cfg.output, plan.WriteText)
+	}
+	if cfg.plan != 0 {
+		plan, err := app.Plan(ctx, application.BatchOperationMove, uuids)
+		if err != nil {
+			return err
+		}
+		if err := app.CheckPlan(ctx, cfg.plan, plan); err != nil {
+			return err
+		}
+	}
+
 	logger := cfg.Logger()
+	reindex := app.Config.Workflows.Move.Reindex.Enabled
 
+	pending := make([]uuid.UUID, 0, len(uuids))
 	for _, id := range uuids {
-		workflowID := fmt.Sprintf("AIP_Move_%s", id.String())
-		// Allow duplicate execution only when the previous run closed
-		// unsuccessfully. This prevents two healthy runs from processing the
-		// same AIP at the same time.
-		options := client.StartWorkflowOptions{
-			ID:                    workflowID,
-			TaskQueue:             app.Config.Temporal.TaskQueue,
-			WorkflowIDReusePolicy: enums.WORKFLOW_ID_REUSE_POLICY_ALLOW_DUPLICATE_FAILED_ONLY,
-		}
-		params := application.MoveWorkflowParams{
-			UUID: id,
-		}
 		aip, err := app.GetAIPByID(ctx, id.String())
 		if err != nil && !errors.Is(err, sql.ErrNoRows) {
 			return fmt.Errorf("get AIP by ID: %w", err)
-		} else if aip != nil && aip.Status == string(application.AIPStatusMoved) {
-			logger.Info("AIP Already Moved")
-			continue
+		} else if aip != nil && (aip.Status == string(application.AIPStatusMoved) || aip.Status == string(application.AIPStatusIndexed) || (aip.Status == string(application.AIPStatusCleaned) && aip.Moved)) {
+			// The workflow of a moved AIP only re-indexes it.
+			if !reindex || aip.ReIndexed {
+				logger.Info("AIP Already Moved", "UUID", id.String())
+				continue
+			}
 		} else if aip != nil && aip.Status == string(application.AIPStatusNotFound) {
-			logger.Info("AIP Not Found")
+			logger.Info("AIP Not Found", "UUID", id.String())
 			continue
 		}
+		pending = append(pending, id)
+	}
+	if len(pending) == 0 {
+		logger.Info("No AIPs left to move.")
+		return nil
+	}
 
-		var we client.WorkflowRun
-		for {
-			we, err = app.Tc.ExecuteWorkflow(ctx, options, application.MoveWorkflowName, params)
-			if err != nil {
-				var alreadyStarted *serviceerror.WorkflowExecutionAlreadyStarted
-				if errors.As(err, &alreadyStarted) {
-					logger.Info("Workflow already running, retrying shortly.", "workflow_id", workflowID)
-					select {
-					case <-ctx.Done():
-						return ctx.Err()
-					case <-time.After(10 * time.Second):
-					}
-					continue
-				}
-				logger.Error("Workflow launch failed.", "err", err)
-				break
-			}
-			break
-		}
-		if we == nil {
-			continue
-		}
-		var result application.MoveWorkflowResult
-		err = we.Get(ctx, &result)
-		if err != nil {
-			logger.Error("Workflow execution failed.", "error", err)
-			continue
-		}
-		logger.Info("workflow", "ID", we.GetID())
+	order := cfg.order
+	if order == "" {
+		order = app.Config.Workflows.Batch.Order
+	}
+	if err := app.OrderUUIDs(ctx, pending, order); err != nil {
+		return fmt.Errorf("order AIPs: %w", err)
+	}
+
+	we, err := app.StartBatch(ctx, application.BatchOperationMove, pending, cfg.maxConcurrent)
+	if err != nil {
+		return fmt.Errorf("start batch workflow: %w", err)
+	}
+	logger.Info("Batch submitted.", "batch_id", we.GetID(), "aips", len(pending))
+	_, _ = fmt.Fprintln(cfg.Stdout, we.GetID())
+
+	if !cfg.wait {
+		return nil
+	}
+
+	var result application.BatchWorkflowResult
+	if err := we.Get(ctx, &result); err != nil {
+		return fmt.Errorf("batch workflow: %w", err)
 	}
+	logger.Info("Batch completed.", "completed", result.Completed, "failed", result.Failed, "skipped", result.Skipped, "cancelled", result.Cancelled)
 
 	return nil
 }
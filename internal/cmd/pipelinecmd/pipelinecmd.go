@@ -0,0 +1,106 @@
+package pipelinecmd
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/peterbourgon/ff/v4"
+
+	"github.com/artefactual-labs/migrate/internal/application"
+	"github.com/artefactual-labs/migrate/internal/cmd/rootcmd"
+)
+
+type Config struct {
+	*rootcmd.RootConfig
+	Command *ff.Command
+	Flags   *ff.FlagSet
+
+	wait          bool
+	maxConcurrent int
+	order         string
+	input         string
+}
+
+func New(parent *rootcmd.RootConfig) *Config {
+	cfg := &Config{RootConfig: parent}
+	cfg.Flags = ff.NewFlagSet("pipeline").SetParent(parent.Flags)
+	cfg.Flags.BoolVar(&cfg.wait, 0, "wait", "Wait for the batch to finish before returning.")
+	cfg.Flags.IntVar(&cfg.maxConcurrent, 0, "max-concurrent", 0, "Maximum number of AIPs processed at the same time (defaults to workflows.batch.max_concurrent).")
+	cfg.Flags.StringVar(&cfg.order, 0, "order", "", "Submission order: input, largest-first, smallest-first or priority (defaults to workflows.batch.order).")
+	cfg.Flags.StringVar(&cfg.input, 0, "input", application.InputFile, "File listing the AIPs, or - to read them from stdin.")
+
+	cfg.Command = &ff.Command{
+		Name:      "pipeline",
+		Usage:     "migrate pipeline [FLAGS]",
+		ShortHelp: "Run the configured pipeline steps for AIPs listed in the input file.",
+		Flags:     cfg.Flags,
+		Exec:      cfg.Exec,
+	}
+
+	parent.Command.Subcommands = append(parent.Command.Subcommands, cfg.Command)
+	return cfg
+}
+
+func (cfg *Config) Exec(ctx context.Context, _ []string) error {
+	app, err := cfg.App(ctx)
+	if err != nil {
+		return err
+	}
+
+	input, err := cfg.LoadInput(ctx, cfg.input)
+	if err != nil {
+		return err
+	}
+	uuids := input.UUIDs()
+
+	logger := cfg.Logger()
+
+	pending := make([]uuid.UUID, 0, len(uuids))
+	for _, id := range uuids {
+		aip, err := app.GetAIPByID(ctx, id.String())
+		if err != nil && !errors.Is(err, sql.ErrNoRows) {
+			return fmt.Errorf("get AIP by ID: %w", err)
+		} else if aip != nil && aip.Status == string(application.AIPStatusFinished) {
+			logger.Info("AIP Already Finished", "UUID", id.String())
+			continue
+		} else if aip != nil && aip.Status == string(application.AIPStatusNotFound) {
+			logger.Info("AIP Not Found", "UUID", id.String())
+			continue
+		}
+		pending = append(pending, id)
+	}
+	if len(pending) == 0 {
+		logger.Info("No AIPs left to process.")
+		return nil
+	}
+
+	order := cfg.order
+	if order == "" {
+		order = app.Config.Workflows.Batch.Order
+	}
+	if err := app.OrderUUIDs(ctx, pending, order); err != nil {
+		return fmt.Errorf("order AIPs: %w", err)
+	}
+
+	we, err := app.StartBatch(ctx, application.BatchOperationPipeline, pending, cfg.maxConcurrent)
+	if err != nil {
+		return fmt.Errorf("start batch workflow: %w", err)
+	}
+	logger.Info("Batch submitted.", "batch_id", we.GetID(), "aips", len(pending))
+	_, _ = fmt.Fprintln(cfg.Stdout, we.GetID())
+
+	if !cfg.wait {
+		return nil
+	}
+
+	var result application.BatchWorkflowResult
+	if err := we.Get(ctx, &result); err != nil {
+		return fmt.Errorf("batch workflow: %w", err)
+	}
+	logger.Info("Batch completed.", "completed", result.Completed, "failed", result.Failed, "skipped", result.Skipped, "cancelled", result.Cancelled)
+
+	return nil
+}
@@ -0,0 +1,59 @@
+package rootcmd
+
+import (
+	"cmp"
+	"context"
+	"fmt"
+
+	"github.com/google/uuid"
+
+	"github.com/artefactual-labs/migrate/internal/application"
+)
+
+// LoadAIPs loads the AIPs processed by a move or replicate run: those listed
+// in the input file at path or, when only batchName is given, the AIPs of that
+// batch. With a batch name, the AIPs of the input are added to the batch, and
+// the batch, created if needed, records op. Dry runs do not change the batch.
+func (cfg *RootConfig) LoadAIPs(ctx context.Context, op application.BatchOperation, path, batchName string, dryRun bool) ([]uuid.UUID, error) {
+	app, err := cfg.App(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if batchName == "" || path != "" {
+		input, err := cfg.LoadInput(ctx, cmp.Or(path, application.InputFile))
+		if err != nil {
+			return nil, err
+		}
+		uuids := input.UUIDs()
+		if batchName == "" || dryRun {
+			return uuids, nil
+		}
+		batch, err := app.EnsureBatch(ctx, batchName, op, input)
+		if err != nil {
+			return nil, err
+		}
+		if err := app.AddBatchAIPs(ctx, batch, uuids); err != nil {
+			return nil, fmt.Errorf("add AIPs to batch: %w", err)
+		}
+		return uuids, nil
+	}
+
+	batch, err := app.GetBatch(ctx, batchName)
+	if err != nil {
+		return nil, err
+	}
+	if !dryRun {
+		if batch, err = app.EnsureBatch(ctx, batchName, op, nil); err != nil {
+			return nil, err
+		}
+	}
+	uuids, err := app.BatchUUIDs(ctx, batch)
+	if err != nil {
+		return nil, err
+	}
+	if len(uuids) == 0 {
+		return nil, fmt.Errorf("batch %q has no AIPs", batchName)
+	}
+	return uuids, nil
+}
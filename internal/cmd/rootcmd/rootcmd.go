@@ -116,7 +116,24 @@ func (cfg *RootConfig) initApp(ctx context.Context) (*application.App, error) {
 	return application.New(logger, db, config, temporalClient, storageClient), nil
 }
 
-func initDatabase(ctx context.Context, datasource string) (db bob.DB, err error) {
+// Database loads the configuration and opens the database without checking
+// its schema, for the commands that manage it. It also returns the path of
+// the database.
+func (cfg *RootConfig) Database(ctx context.Context) (bob.DB, string, error) {
+	config, path, err := application.LoadConfig()
+	if err != nil {
+		return bob.DB{}, "", err
+	}
+
+	cfg.Logger().Info("Loaded config.", slog.String("path", path))
+
+	datasource := config.Database.SQLite.Path
+	db, err := openDatabase(ctx, datasource)
+	return db, datasource, err
+}
+
+// openDatabase opens the SQLite database, creating its directory if needed.
+func openDatabase(ctx context.Context, datasource string) (db bob.DB, err error) {
 	if datasource == "" {
 		return db, fmt.Errorf("sqlite path not configured")
 	}
@@ -137,14 +154,29 @@ func initDatabase(ctx context.Context, datasource string) (db bob.DB, err error)
 		return db, fmt.Errorf("ping db: %w", err)
 	}
 
-	var file []byte
-	file, err = migrations.FS.ReadFile("schema.sql")
+	return db, nil
+}
+
+// initDatabase opens the database and checks that its schema is up to date.
+// A new database is created with the latest schema, but existing ones are
+// only migrated by the db migrate command, which backs them up first.
+func initDatabase(ctx context.Context, datasource string) (bob.DB, error) {
+	db, err := openDatabase(ctx, datasource)
 	if err != nil {
-		return db, fmt.Errorf("read schema.sql: %w", err)
+		return db, err
 	}
 
-	if _, err = db.ExecContext(ctx, string(file)); err != nil {
-		return db, fmt.Errorf("exec schema.sql: %w", err)
+	status, err := migrations.GetStatus(ctx, db)
+	if err != nil {
+		return db, fmt.Errorf("read schema version: %w", err)
+	}
+	switch {
+	case status.Empty:
+		if _, err := migrations.Migrate(ctx, db); err != nil {
+			return db, fmt.Errorf("create database: %w", err)
+		}
+	case len(status.Pending) > 0:
+		return db, fmt.Errorf("database schema is at version %d but the latest is %d, run \"migrate db migrate\" to upgrade it", status.Version, status.Latest())
 	}
 
 	return db, nil
@@ -0,0 +1,52 @@
+package rootcmd
+
+import (
+	"context"
+	"errors"
+	"io"
+	"os"
+
+	"github.com/artefactual-labs/migrate/internal/application"
+)
+
+// Stdio is the path that stands for stdin or stdout in --input and --output.
+const Stdio = "-"
+
+// OpenInput opens the file at path for reading, or returns stdin for "-".
+func (cfg *RootConfig) OpenInput(path string) (io.ReadCloser, error) {
+	if path == Stdio {
+		return io.NopCloser(cfg.Stdin), nil
+	}
+	return os.Open(path)
+}
+
+// WriteOutput calls write with the file created at path, or with stdout for
+// "-", and closes the file.
+func (cfg *RootConfig) WriteOutput(path string, write func(io.Writer) error) (err error) {
+	if path == Stdio {
+		return write(cfg.Stdout)
+	}
+	f, err := os.Create(path)
+	if err != nil {
+		return err
+	}
+	defer func() { err = errors.Join(err, f.Close()) }()
+
+	return write(f)
+}
+
+// LoadInput loads the AIPs listed in the input file at path, or in stdin for
+// "-", into the database.
+func (cfg *RootConfig) LoadInput(ctx context.Context, path string) (*application.Input, error) {
+	app, err := cfg.App(ctx)
+	if err != nil {
+		return nil, err
+	}
+	r, err := cfg.OpenInput(path)
+	if err != nil {
+		return nil, err
+	}
+	defer r.Close() //nolint:errcheck
+
+	return app.LoadInput(ctx, path, r)
+}
@@ -0,0 +1,91 @@
+package statuscmd
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/peterbourgon/ff/v4"
+
+	"github.com/artefactual-labs/migrate/internal/cmd/rootcmd"
+	"github.com/artefactual-labs/migrate/internal/database/gen/models"
+)
+
+type Config struct {
+	*rootcmd.RootConfig
+	Command *ff.Command
+	Flags   *ff.FlagSet
+
+	watch    bool
+	interval time.Duration
+	window   time.Duration
+	json     bool
+	batch    string
+}
+
+func New(parent *rootcmd.RootConfig) *Config {
+	cfg := &Config{RootConfig: parent}
+	cfg.Flags = ff.NewFlagSet("status").SetParent(parent.Flags)
+	cfg.Flags.BoolVar(&cfg.watch, 0, "watch", "Refresh the status until interrupted.")
+	cfg.Flags.DurationVar(&cfg.interval, 0, "interval", 5*time.Second, "Refresh interval used with --watch.")
+	cfg.Flags.DurationVar(&cfg.window, 0, "window", time.Hour, "Window used to measure recent throughput.")
+	cfg.Flags.BoolVar(&cfg.json, 0, "json", "Print the status as JSON, one object per line.")
+	cfg.Flags.StringVar(&cfg.batch, 0, "batch", "", "Only show the progress of the AIPs of this batch.")
+
+	cfg.Command = &ff.Command{
+		Name:      "status",
+		Usage:     "migrate status [FLAGS]",
+		ShortHelp: "Show the progress of the migration.",
+		Flags:     cfg.Flags,
+		Exec:      cfg.Exec,
+	}
+
+	parent.Command.Subcommands = append(parent.Command.Subcommands, cfg.Command)
+	return cfg
+}
+
+func (cfg *Config) Exec(ctx context.Context, _ []string) error {
+	app, err := cfg.App(ctx)
+	if err != nil {
+		return err
+	}
+
+	var batch *models.Batch
+	if cfg.batch != "" {
+		if batch, err = app.GetBatch(ctx, cfg.batch); err != nil {
+			return err
+		}
+	}
+
+	for {
+		status, err := app.Status(ctx, cfg.window, batch)
+		if err != nil {
+			return err
+		}
+
+		if cfg.json {
+			if err := json.NewEncoder(cfg.Stdout).Encode(status); err != nil {
+				return err
+			}
+		} else {
+			if cfg.watch {
+				// Clear the screen before each refresh.
+				_, _ = fmt.Fprint(cfg.Stdout, "\033[H\033[2J")
+			}
+			if err := status.WriteText(cfg.Stdout); err != nil {
+				return err
+			}
+		}
+
+		if !cfg.watch {
+			return nil
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-time.After(cfg.interval):
+		}
+	}
+}
@@ -6,6 +6,7 @@ import (
 
 	"github.com/peterbourgon/ff/v4"
 	"go.temporal.io/sdk/activity"
+	"go.temporal.io/sdk/interceptor"
 	"go.temporal.io/sdk/worker"
 	"go.temporal.io/sdk/workflow"
 
@@ -59,6 +60,7 @@ func registerWorker(app *application.App) worker.Worker {
 		MaxConcurrentActivityExecutionSize:      app.Config.Temporal.MaxConcurrentActivityExecutionSize,
 		MaxConcurrentLocalActivityExecutionSize: app.Config.Temporal.MaxConcurrentLocalActivityExecutionSize,
 		MaxConcurrentWorkflowTaskExecutionSize:  app.Config.Temporal.MaxConcurrentWorkflowTaskExecutionSize,
+		Interceptors:                            []interceptor.WorkerInterceptor{application.NewErrorInterceptor()},
 	})
 
 	w.RegisterWorkflowWithOptions(
@@ -75,6 +77,20 @@ func registerWorker(app *application.App) worker.Worker {
 		},
 	)
 
+	w.RegisterWorkflowWithOptions(
+		application.NewPipelineWorkflow(app).Run,
+		workflow.RegisterOptions{
+			Name: application.PipelineWorkflowName,
+		},
+	)
+
+	w.RegisterWorkflowWithOptions(
+		application.NewBatchWorkflow(app).Run,
+		workflow.RegisterOptions{
+			Name: application.BatchWorkflowName,
+		},
+	)
+
 	w.RegisterActivityWithOptions(
 		application.NewCheckStorageServiceConnectionActivity(app.StorageClient).Execute,
 		activity.RegisterOptions{Name: application.CheckStorageServiceConnectionActivityName},
@@ -84,7 +100,21 @@ func registerWorker(app *application.App) worker.Worker {
 	w.RegisterActivityWithOptions(app.FindA, activity.RegisterOptions{Name: application.FindAName})
 	w.RegisterActivityWithOptions(app.CheckReplicationStatus, activity.RegisterOptions{Name: application.CheckReplicationStatusName})
 	w.RegisterActivityWithOptions(app.FixityA, activity.RegisterOptions{Name: application.FixityActivityName})
+	w.RegisterActivityWithOptions(app.DestinationFixityA, activity.RegisterOptions{Name: application.DestinationFixityActivityName})
 	w.RegisterActivityWithOptions(app.MoveA, activity.RegisterOptions{Name: application.MoveActivityName})
+	w.RegisterActivityWithOptions(app.StartMoveA, activity.RegisterOptions{Name: application.StartMoveActivityName})
+	w.RegisterActivityWithOptions(app.PollMoveA, activity.RegisterOptions{Name: application.PollMoveActivityName})
+	w.RegisterActivityWithOptions(app.AcquireLocationLeases, activity.RegisterOptions{Name: application.AcquireLocationLeasesName})
+	w.RegisterActivityWithOptions(app.ReleaseLocationLeases, activity.RegisterOptions{Name: application.ReleaseLocationLeasesName})
+	w.RegisterActivityWithOptions(app.CancelAIPs, activity.RegisterOptions{Name: application.CancelAIPsName})
+	w.RegisterActivityWithOptions(app.LoadBatchAIPs, activity.RegisterOptions{Name: application.LoadBatchAIPsName})
+	w.RegisterActivityWithOptions(app.UpdateAIPStatusA, activity.RegisterOptions{Name: application.UpdateAIPStatusName})
+	w.RegisterActivityWithOptions(app.LoadAIPSteps, activity.RegisterOptions{Name: application.LoadAIPStepsName})
+	w.RegisterActivityWithOptions(app.RecordAIPStep, activity.RegisterOptions{Name: application.RecordAIPStepName})
+	w.RegisterActivityWithOptions(app.VerifyA, activity.RegisterOptions{Name: application.VerifyActivityName})
+	w.RegisterActivityWithOptions(app.VerifyReplicasA, activity.RegisterOptions{Name: application.VerifyReplicasActivityName})
+	w.RegisterActivityWithOptions(app.CleanupA, activity.RegisterOptions{Name: application.CleanupActivityName})
+	w.RegisterActivityWithOptions(app.ReindexA, activity.RegisterOptions{Name: application.ReindexActivityName})
 
 	return w
 }
@@ -69,6 +69,33 @@ var AipReplications = Table[
 			Generated: false,
 			AutoIncr:  false,
 		},
+		FixityStatus: column{
+			Name:      "fixity_status",
+			DBType:    "TEXT",
+			Default:   "NULL",
+			Comment:   "",
+			Nullable:  true,
+			Generated: false,
+			AutoIncr:  false,
+		},
+		FixityCheckedAt: column{
+			Name:      "fixity_checked_at",
+			DBType:    "TEXT",
+			Default:   "NULL",
+			Comment:   "",
+			Nullable:  true,
+			Generated: false,
+			AutoIncr:  false,
+		},
+		Rule: column{
+			Name:      "rule",
+			DBType:    "TEXT",
+			Default:   "''",
+			Comment:   "",
+			Nullable:  false,
+			Generated: false,
+			AutoIncr:  false,
+		},
 	},
 	Indexes: aipReplicationIndexes{
 		PKMainAipReplication: index{
@@ -107,17 +134,20 @@ var AipReplications = Table[
 }
 
 type aipReplicationColumns struct {
-	ID           column
-	AipID        column
-	LocationUUID column
-	ReplicaUUID  column
-	Status       column
-	Attempt      column
+	ID              column
+	AipID           column
+	LocationUUID    column
+	ReplicaUUID     column
+	Status          column
+	Attempt         column
+	FixityStatus    column
+	FixityCheckedAt column
+	Rule            column
 }
 
 func (c aipReplicationColumns) AsSlice() []column {
 	return []column{
-		c.ID, c.AipID, c.LocationUUID, c.ReplicaUUID, c.Status, c.Attempt,
+		c.ID, c.AipID, c.LocationUUID, c.ReplicaUUID, c.Status, c.Attempt, c.FixityStatus, c.FixityCheckedAt, c.Rule,
 	}
 }
 
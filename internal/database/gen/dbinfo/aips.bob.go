@@ -123,6 +123,60 @@ var Aips = Table[
 			Generated: false,
 			AutoIncr:  false,
 		},
+		Priority: column{
+			Name:      "priority",
+			DBType:    "INTEGER",
+			Default:   "0",
+			Comment:   "",
+			Nullable:  false,
+			Generated: false,
+			AutoIncr:  false,
+		},
+		SourceLocationUUID: column{
+			Name:      "source_location_uuid",
+			DBType:    "TEXT",
+			Default:   "''",
+			Comment:   "",
+			Nullable:  false,
+			Generated: false,
+			AutoIncr:  false,
+		},
+		MoveTargetLocationUUID: column{
+			Name:      "move_target_location_uuid",
+			DBType:    "TEXT",
+			Default:   "''",
+			Comment:   "",
+			Nullable:  false,
+			Generated: false,
+			AutoIncr:  false,
+		},
+		MoveTargetOverride: column{
+			Name:      "move_target_override",
+			DBType:    "TEXT",
+			Default:   "''",
+			Comment:   "",
+			Nullable:  false,
+			Generated: false,
+			AutoIncr:  false,
+		},
+		ReplicationTargetsOverride: column{
+			Name:      "replication_targets_override",
+			DBType:    "TEXT",
+			Default:   "''",
+			Comment:   "",
+			Nullable:  false,
+			Generated: false,
+			AutoIncr:  false,
+		},
+		Tags: column{
+			Name:      "tags",
+			DBType:    "TEXT",
+			Default:   "''",
+			Comment:   "",
+			Nullable:  false,
+			Generated: false,
+			AutoIncr:  false,
+		},
 	},
 	Indexes: aipIndexes{
 		PKMainAips: index{
@@ -186,23 +240,29 @@ var Aips = Table[
 }
 
 type aipColumns struct {
-	ID              column
-	UUID            column
-	Status          column
-	Found           column
-	FixityRun       column
-	Moved           column
-	Cleaned         column
-	Replicated      column
-	ReIndexed       column
-	CurrentLocation column
-	Size            column
-	LocationUUID    column
+	ID                         column
+	UUID                       column
+	Status                     column
+	Found                      column
+	FixityRun                  column
+	Moved                      column
+	Cleaned                    column
+	Replicated                 column
+	ReIndexed                  column
+	CurrentLocation            column
+	Size                       column
+	LocationUUID               column
+	Priority                   column
+	SourceLocationUUID         column
+	MoveTargetLocationUUID     column
+	MoveTargetOverride         column
+	ReplicationTargetsOverride column
+	Tags                       column
 }
 
 func (c aipColumns) AsSlice() []column {
 	return []column{
-		c.ID, c.UUID, c.Status, c.Found, c.FixityRun, c.Moved, c.Cleaned, c.Replicated, c.ReIndexed, c.CurrentLocation, c.Size, c.LocationUUID,
+		c.ID, c.UUID, c.Status, c.Found, c.FixityRun, c.Moved, c.Cleaned, c.Replicated, c.ReIndexed, c.CurrentLocation, c.Size, c.LocationUUID, c.Priority, c.SourceLocationUUID, c.MoveTargetLocationUUID, c.MoveTargetOverride, c.ReplicationTargetsOverride, c.Tags,
 	}
 }
 
@@ -87,6 +87,24 @@ var Events = Table[
 			Generated: false,
 			AutoIncr:  false,
 		},
+		Success: column{
+			Name:      "success",
+			DBType:    "BOOLEAN",
+			Default:   "TRUE",
+			Comment:   "",
+			Nullable:  false,
+			Generated: false,
+			AutoIncr:  false,
+		},
+		EndedAt: column{
+			Name:      "ended_at",
+			DBType:    "INTEGER",
+			Default:   "NULL",
+			Comment:   "",
+			Nullable:  true,
+			Generated: false,
+			AutoIncr:  false,
+		},
 	},
 	Indexes: eventIndexes{
 		PKMainEvents: index{
@@ -103,6 +121,20 @@ var Events = Table[
 			Comment: "",
 			Partial: false,
 		},
+		EventsEndedAtIdx: index{
+			Type: "c",
+			Name: "events_ended_at_idx",
+			Columns: []indexColumn{
+				{
+					Name:         "ended_at",
+					Desc:         null.FromCond(false, true),
+					IsExpression: false,
+				},
+			},
+			Unique:  false,
+			Comment: "",
+			Partial: false,
+		},
 	},
 	PrimaryKey: &constraint{
 		Name:    "pk_main_events",
@@ -133,21 +165,24 @@ type eventColumns struct {
 	TotalDuration            column
 	TotalDurationNanoseconds column
 	Details                  column
+	Success                  column
+	EndedAt                  column
 }
 
 func (c eventColumns) AsSlice() []column {
 	return []column{
-		c.ID, c.AipID, c.Action, c.TimeStarted, c.TimeEnded, c.TotalDuration, c.TotalDurationNanoseconds, c.Details,
+		c.ID, c.AipID, c.Action, c.TimeStarted, c.TimeEnded, c.TotalDuration, c.TotalDurationNanoseconds, c.Details, c.Success, c.EndedAt,
 	}
 }
 
 type eventIndexes struct {
-	PKMainEvents index
+	PKMainEvents     index
+	EventsEndedAtIdx index
 }
 
 func (i eventIndexes) AsSlice() []index {
 	return []index{
-		i.PKMainEvents,
+		i.PKMainEvents, i.EventsEndedAtIdx,
 	}
 }
 
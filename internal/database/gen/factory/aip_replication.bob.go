@@ -36,12 +36,15 @@ func (mods AipReplicationModSlice) Apply(ctx context.Context, n *AipReplicationT
 // AipReplicationTemplate is an object representing the database table.
 // all columns are optional and should be set by mods
 type AipReplicationTemplate struct {
-	ID           func() int64
-	AipID        func() int64
-	LocationUUID func() null.Val[string]
-	ReplicaUUID  func() null.Val[string]
-	Status       func() string
-	Attempt      func() int64
+	ID              func() int64
+	AipID           func() int64
+	LocationUUID    func() null.Val[string]
+	ReplicaUUID     func() null.Val[string]
+	Status          func() string
+	Attempt         func() int64
+	FixityStatus    func() null.Val[string]
+	FixityCheckedAt func() null.Val[string]
+	Rule            func() string
 
 	r aipReplicationR
 	f *Factory
@@ -104,6 +107,18 @@ func (o AipReplicationTemplate) BuildSetter() *models.AipReplicationSetter {
 		val := o.Attempt()
 		m.Attempt = omit.From(val)
 	}
+	if o.FixityStatus != nil {
+		val := o.FixityStatus()
+		m.FixityStatus = omitnull.FromNull(val)
+	}
+	if o.FixityCheckedAt != nil {
+		val := o.FixityCheckedAt()
+		m.FixityCheckedAt = omitnull.FromNull(val)
+	}
+	if o.Rule != nil {
+		val := o.Rule()
+		m.Rule = omit.From(val)
+	}
 
 	return m
 }
@@ -144,6 +159,15 @@ func (o AipReplicationTemplate) Build() *models.AipReplication {
 	if o.Attempt != nil {
 		m.Attempt = o.Attempt()
 	}
+	if o.FixityStatus != nil {
+		m.FixityStatus = o.FixityStatus()
+	}
+	if o.FixityCheckedAt != nil {
+		m.FixityCheckedAt = o.FixityCheckedAt()
+	}
+	if o.Rule != nil {
+		m.Rule = o.Rule()
+	}
 
 	o.setModelRels(m)
 
@@ -293,6 +317,9 @@ func (m aipReplicationMods) RandomizeAllColumns(f *faker.Faker) AipReplicationMo
 		AipReplicationMods.RandomReplicaUUID(f),
 		AipReplicationMods.RandomStatus(f),
 		AipReplicationMods.RandomAttempt(f),
+		AipReplicationMods.RandomFixityStatus(f),
+		AipReplicationMods.RandomFixityCheckedAt(f),
+		AipReplicationMods.RandomRule(f),
 	}
 }
 
@@ -526,6 +553,143 @@ func (m aipReplicationMods) RandomAttempt(f *faker.Faker) AipReplicationMod {
 	})
 }
 
+// Set the model columns to this value
+func (m aipReplicationMods) FixityStatus(val null.Val[string]) AipReplicationMod {
+	return AipReplicationModFunc(func(_ context.Context, o *AipReplicationTemplate) {
+		o.FixityStatus = func() null.Val[string] { return val }
+	})
+}
+
+// Set the Column from the function
+func (m aipReplicationMods) FixityStatusFunc(f func() null.Val[string]) AipReplicationMod {
+	return AipReplicationModFunc(func(_ context.Context, o *AipReplicationTemplate) {
+		o.FixityStatus = f
+	})
+}
+
+// Clear any values for the column
+func (m aipReplicationMods) UnsetFixityStatus() AipReplicationMod {
+	return AipReplicationModFunc(func(_ context.Context, o *AipReplicationTemplate) {
+		o.FixityStatus = nil
+	})
+}
+
+// Generates a random value for the column using the given faker
+// if faker is nil, a default faker is used
+// The generated value is sometimes null
+func (m aipReplicationMods) RandomFixityStatus(f *faker.Faker) AipReplicationMod {
+	return AipReplicationModFunc(func(_ context.Context, o *AipReplicationTemplate) {
+		o.FixityStatus = func() null.Val[string] {
+			if f == nil {
+				f = &defaultFaker
+			}
+
+			val := random_string(f)
+			return null.From(val)
+		}
+	})
+}
+
+// Generates a random value for the column using the given faker
+// if faker is nil, a default faker is used
+// The generated value is never null
+func (m aipReplicationMods) RandomFixityStatusNotNull(f *faker.Faker) AipReplicationMod {
+	return AipReplicationModFunc(func(_ context.Context, o *AipReplicationTemplate) {
+		o.FixityStatus = func() null.Val[string] {
+			if f == nil {
+				f = &defaultFaker
+			}
+
+			val := random_string(f)
+			return null.From(val)
+		}
+	})
+}
+
+// Set the model columns to this value
+func (m aipReplicationMods) FixityCheckedAt(val null.Val[string]) AipReplicationMod {
+	return AipReplicationModFunc(func(_ context.Context, o *AipReplicationTemplate) {
+		o.FixityCheckedAt = func() null.Val[string] { return val }
+	})
+}
+
+// Set the Column from the function
+func (m aipReplicationMods) FixityCheckedAtFunc(f func() null.Val[string]) AipReplicationMod {
+	return AipReplicationModFunc(func(_ context.Context, o *AipReplicationTemplate) {
+		o.FixityCheckedAt = f
+	})
+}
+
+// Clear any values for the column
+func (m aipReplicationMods) UnsetFixityCheckedAt() AipReplicationMod {
+	return AipReplicationModFunc(func(_ context.Context, o *AipReplicationTemplate) {
+		o.FixityCheckedAt = nil
+	})
+}
+
+// Generates a random value for the column using the given faker
+// if faker is nil, a default faker is used
+// The generated value is sometimes null
+func (m aipReplicationMods) RandomFixityCheckedAt(f *faker.Faker) AipReplicationMod {
+	return AipReplicationModFunc(func(_ context.Context, o *AipReplicationTemplate) {
+		o.FixityCheckedAt = func() null.Val[string] {
+			if f == nil {
+				f = &defaultFaker
+			}
+
+			val := random_string(f)
+			return null.From(val)
+		}
+	})
+}
+
+// Generates a random value for the column using the given faker
+// if faker is nil, a default faker is used
+// The generated value is never null
+func (m aipReplicationMods) RandomFixityCheckedAtNotNull(f *faker.Faker) AipReplicationMod {
+	return AipReplicationModFunc(func(_ context.Context, o *AipReplicationTemplate) {
+		o.FixityCheckedAt = func() null.Val[string] {
+			if f == nil {
+				f = &defaultFaker
+			}
+
+			val := random_string(f)
+			return null.From(val)
+		}
+	})
+}
+
+// Set the model columns to this value
+func (m aipReplicationMods) Rule(val string) AipReplicationMod {
+	return AipReplicationModFunc(func(_ context.Context, o *AipReplicationTemplate) {
+		o.Rule = func() string { return val }
+	})
+}
+
+// Set the Column from the function
+func (m aipReplicationMods) RuleFunc(f func() string) AipReplicationMod {
+	return AipReplicationModFunc(func(_ context.Context, o *AipReplicationTemplate) {
+		o.Rule = f
+	})
+}
+
+// Clear any values for the column
+func (m aipReplicationMods) UnsetRule() AipReplicationMod {
+	return AipReplicationModFunc(func(_ context.Context, o *AipReplicationTemplate) {
+		o.Rule = nil
+	})
+}
+
+// Generates a random value for the column using the given faker
+// if faker is nil, a default faker is used
+func (m aipReplicationMods) RandomRule(f *faker.Faker) AipReplicationMod {
+	return AipReplicationModFunc(func(_ context.Context, o *AipReplicationTemplate) {
+		o.Rule = func() string {
+			return random_string(f)
+		}
+	})
+}
+
 func (m aipReplicationMods) WithParentsCascading() AipReplicationMod {
 	return AipReplicationModFunc(func(ctx context.Context, o *AipReplicationTemplate) {
 		if isDone, _ := aipReplicationWithParentsCascadingCtx.Value(ctx); isDone {
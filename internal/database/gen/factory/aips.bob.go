@@ -36,18 +36,24 @@ func (mods AipModSlice) Apply(ctx context.Context, n *AipTemplate) {
 // AipTemplate is an object representing the database table.
 // all columns are optional and should be set by mods
 type AipTemplate struct {
-	ID              func() int64
-	UUID            func() string
-	Status          func() string
-	Found           func() bool
-	FixityRun       func() bool
-	Moved           func() bool
-	Cleaned         func() bool
-	Replicated      func() bool
-	ReIndexed       func() bool
-	CurrentLocation func() null.Val[string]
-	Size            func() null.Val[int64]
-	LocationUUID    func() null.Val[string]
+	ID                         func() int64
+	UUID                       func() string
+	Status                     func() string
+	Found                      func() bool
+	FixityRun                  func() bool
+	Moved                      func() bool
+	Cleaned                    func() bool
+	Replicated                 func() bool
+	ReIndexed                  func() bool
+	CurrentLocation            func() null.Val[string]
+	Size                       func() null.Val[int64]
+	LocationUUID               func() null.Val[string]
+	Priority                   func() int64
+	SourceLocationUUID         func() string
+	MoveTargetLocationUUID     func() string
+	MoveTargetOverride         func() string
+	ReplicationTargetsOverride func() string
+	Tags                       func() string
 
 	r aipR
 	f *Factory
@@ -57,6 +63,8 @@ type AipTemplate struct {
 
 type aipR struct {
 	AipReplications []*aipRAipReplicationsR
+	AipSteps        []*aipRAipStepsR
+	BatchAips       []*aipRBatchAipsR
 	Errors          []*aipRErrorsR
 	Events          []*aipREventsR
 }
@@ -65,6 +73,14 @@ type aipRAipReplicationsR struct {
 	number int
 	o      *AipReplicationTemplate
 }
+type aipRAipStepsR struct {
+	number int
+	o      *AipStepTemplate
+}
+type aipRBatchAipsR struct {
+	number int
+	o      *BatchAipTemplate
+}
 type aipRErrorsR struct {
 	number int
 	o      *ErrorTemplate
@@ -97,6 +113,32 @@ func (t AipTemplate) setModelRels(o *models.Aip) {
 		o.R.AipReplications = rel
 	}
 
+	if t.r.AipSteps != nil {
+		rel := models.AipStepSlice{}
+		for _, r := range t.r.AipSteps {
+			related := r.o.BuildMany(r.number)
+			for _, rel := range related {
+				rel.AipID = o.ID // h2
+				rel.R.Aip = o
+			}
+			rel = append(rel, related...)
+		}
+		o.R.AipSteps = rel
+	}
+
+	if t.r.BatchAips != nil {
+		rel := models.BatchAipSlice{}
+		for _, r := range t.r.BatchAips {
+			related := r.o.BuildMany(r.number)
+			for _, rel := range related {
+				rel.AipID = o.ID // h2
+				rel.R.Aip = o
+			}
+			rel = append(rel, related...)
+		}
+		o.R.BatchAips = rel
+	}
+
 	if t.r.Errors != nil {
 		rel := models.ErrorSlice{}
 		for _, r := range t.r.Errors {
@@ -177,6 +219,30 @@ func (o AipTemplate) BuildSetter() *models.AipSetter {
 		val := o.LocationUUID()
 		m.LocationUUID = omitnull.FromNull(val)
 	}
+	if o.Priority != nil {
+		val := o.Priority()
+		m.Priority = omit.From(val)
+	}
+	if o.SourceLocationUUID != nil {
+		val := o.SourceLocationUUID()
+		m.SourceLocationUUID = omit.From(val)
+	}
+	if o.MoveTargetLocationUUID != nil {
+		val := o.MoveTargetLocationUUID()
+		m.MoveTargetLocationUUID = omit.From(val)
+	}
+	if o.MoveTargetOverride != nil {
+		val := o.MoveTargetOverride()
+		m.MoveTargetOverride = omit.From(val)
+	}
+	if o.ReplicationTargetsOverride != nil {
+		val := o.ReplicationTargetsOverride()
+		m.ReplicationTargetsOverride = omit.From(val)
+	}
+	if o.Tags != nil {
+		val := o.Tags()
+		m.Tags = omit.From(val)
+	}
 
 	return m
 }
@@ -235,6 +301,24 @@ func (o AipTemplate) Build() *models.Aip {
 	if o.LocationUUID != nil {
 		m.LocationUUID = o.LocationUUID()
 	}
+	if o.Priority != nil {
+		m.Priority = o.Priority()
+	}
+	if o.SourceLocationUUID != nil {
+		m.SourceLocationUUID = o.SourceLocationUUID()
+	}
+	if o.MoveTargetLocationUUID != nil {
+		m.MoveTargetLocationUUID = o.MoveTargetLocationUUID()
+	}
+	if o.MoveTargetOverride != nil {
+		m.MoveTargetOverride = o.MoveTargetOverride()
+	}
+	if o.ReplicationTargetsOverride != nil {
+		m.ReplicationTargetsOverride = o.ReplicationTargetsOverride()
+	}
+	if o.Tags != nil {
+		m.Tags = o.Tags()
+	}
 
 	o.setModelRels(m)
 
@@ -287,6 +371,46 @@ func (o *AipTemplate) insertOptRels(ctx context.Context, exec bob.Executor, m *m
 		}
 	}
 
+	isAipStepsDone, _ := aipRelAipStepsCtx.Value(ctx)
+	if !isAipStepsDone && o.r.AipSteps != nil {
+		ctx = aipRelAipStepsCtx.WithValue(ctx, true)
+		for _, r := range o.r.AipSteps {
+			if r.o.alreadyPersisted {
+				m.R.AipSteps = append(m.R.AipSteps, r.o.Build())
+			} else {
+				rel1, err := r.o.CreateMany(ctx, exec, r.number)
+				if err != nil {
+					return err
+				}
+
+				err = m.AttachAipSteps(ctx, exec, rel1...)
+				if err != nil {
+					return err
+				}
+			}
+		}
+	}
+
+	isBatchAipsDone, _ := aipRelBatchAipsCtx.Value(ctx)
+	if !isBatchAipsDone && o.r.BatchAips != nil {
+		ctx = aipRelBatchAipsCtx.WithValue(ctx, true)
+		for _, r := range o.r.BatchAips {
+			if r.o.alreadyPersisted {
+				m.R.BatchAips = append(m.R.BatchAips, r.o.Build())
+			} else {
+				rel2, err := r.o.CreateMany(ctx, exec, r.number)
+				if err != nil {
+					return err
+				}
+
+				err = m.AttachBatchAips(ctx, exec, rel2...)
+				if err != nil {
+					return err
+				}
+			}
+		}
+	}
+
 	isErrorsDone, _ := aipRelErrorsCtx.Value(ctx)
 	if !isErrorsDone && o.r.Errors != nil {
 		ctx = aipRelErrorsCtx.WithValue(ctx, true)
@@ -294,12 +418,12 @@ func (o *AipTemplate) insertOptRels(ctx context.Context, exec bob.Executor, m *m
 			if r.o.alreadyPersisted {
 				m.R.Errors = append(m.R.Errors, r.o.Build())
 			} else {
-				rel1, err := r.o.CreateMany(ctx, exec, r.number)
+				rel3, err := r.o.CreateMany(ctx, exec, r.number)
 				if err != nil {
 					return err
 				}
 
-				err = m.AttachErrors(ctx, exec, rel1...)
+				err = m.AttachErrors(ctx, exec, rel3...)
 				if err != nil {
 					return err
 				}
@@ -314,12 +438,12 @@ func (o *AipTemplate) insertOptRels(ctx context.Context, exec bob.Executor, m *m
 			if r.o.alreadyPersisted {
 				m.R.Events = append(m.R.Events, r.o.Build())
 			} else {
-				rel2, err := r.o.CreateMany(ctx, exec, r.number)
+				rel4, err := r.o.CreateMany(ctx, exec, r.number)
 				if err != nil {
 					return err
 				}
 
-				err = m.AttachEvents(ctx, exec, rel2...)
+				err = m.AttachEvents(ctx, exec, rel4...)
 				if err != nil {
 					return err
 				}
@@ -431,6 +555,12 @@ func (m aipMods) RandomizeAllColumns(f *faker.Faker) AipMod {
 		AipMods.RandomCurrentLocation(f),
 		AipMods.RandomSize(f),
 		AipMods.RandomLocationUUID(f),
+		AipMods.RandomPriority(f),
+		AipMods.RandomSourceLocationUUID(f),
+		AipMods.RandomMoveTargetLocationUUID(f),
+		AipMods.RandomMoveTargetOverride(f),
+		AipMods.RandomReplicationTargetsOverride(f),
+		AipMods.RandomTags(f),
 	}
 }
 
@@ -872,6 +1002,192 @@ func (m aipMods) RandomLocationUUIDNotNull(f *faker.Faker) AipMod {
 	})
 }
 
+// Set the model columns to this value
+func (m aipMods) Priority(val int64) AipMod {
+	return AipModFunc(func(_ context.Context, o *AipTemplate) {
+		o.Priority = func() int64 { return val }
+	})
+}
+
+// Set the Column from the function
+func (m aipMods) PriorityFunc(f func() int64) AipMod {
+	return AipModFunc(func(_ context.Context, o *AipTemplate) {
+		o.Priority = f
+	})
+}
+
+// Clear any values for the column
+func (m aipMods) UnsetPriority() AipMod {
+	return AipModFunc(func(_ context.Context, o *AipTemplate) {
+		o.Priority = nil
+	})
+}
+
+// Generates a random value for the column using the given faker
+// if faker is nil, a default faker is used
+func (m aipMods) RandomPriority(f *faker.Faker) AipMod {
+	return AipModFunc(func(_ context.Context, o *AipTemplate) {
+		o.Priority = func() int64 {
+			return random_int64(f)
+		}
+	})
+}
+
+// Set the model columns to this value
+func (m aipMods) SourceLocationUUID(val string) AipMod {
+	return AipModFunc(func(_ context.Context, o *AipTemplate) {
+		o.SourceLocationUUID = func() string { return val }
+	})
+}
+
+// Set the Column from the function
+func (m aipMods) SourceLocationUUIDFunc(f func() string) AipMod {
+	return AipModFunc(func(_ context.Context, o *AipTemplate) {
+		o.SourceLocationUUID = f
+	})
+}
+
+// Clear any values for the column
+func (m aipMods) UnsetSourceLocationUUID() AipMod {
+	return AipModFunc(func(_ context.Context, o *AipTemplate) {
+		o.SourceLocationUUID = nil
+	})
+}
+
+// Generates a random value for the column using the given faker
+// if faker is nil, a default faker is used
+func (m aipMods) RandomSourceLocationUUID(f *faker.Faker) AipMod {
+	return AipModFunc(func(_ context.Context, o *AipTemplate) {
+		o.SourceLocationUUID = func() string {
+			return random_string(f)
+		}
+	})
+}
+
+// Set the model columns to this value
+func (m aipMods) MoveTargetLocationUUID(val string) AipMod {
+	return AipModFunc(func(_ context.Context, o *AipTemplate) {
+		o.MoveTargetLocationUUID = func() string { return val }
+	})
+}
+
+// Set the Column from the function
+func (m aipMods) MoveTargetLocationUUIDFunc(f func() string) AipMod {
+	return AipModFunc(func(_ context.Context, o *AipTemplate) {
+		o.MoveTargetLocationUUID = f
+	})
+}
+
+// Clear any values for the column
+func (m aipMods) UnsetMoveTargetLocationUUID() AipMod {
+	return AipModFunc(func(_ context.Context, o *AipTemplate) {
+		o.MoveTargetLocationUUID = nil
+	})
+}
+
+// Generates a random value for the column using the given faker
+// if faker is nil, a default faker is used
+func (m aipMods) RandomMoveTargetLocationUUID(f *faker.Faker) AipMod {
+	return AipModFunc(func(_ context.Context, o *AipTemplate) {
+		o.MoveTargetLocationUUID = func() string {
+			return random_string(f)
+		}
+	})
+}
+
+// Set the model columns to this value
+func (m aipMods) MoveTargetOverride(val string) AipMod {
+	return AipModFunc(func(_ context.Context, o *AipTemplate) {
+		o.MoveTargetOverride = func() string { return val }
+	})
+}
+
+// Set the Column from the function
+func (m aipMods) MoveTargetOverrideFunc(f func() string) AipMod {
+	return AipModFunc(func(_ context.Context, o *AipTemplate) {
+		o.MoveTargetOverride = f
+	})
+}
+
+// Clear any values for the column
+func (m aipMods) UnsetMoveTargetOverride() AipMod {
+	return AipModFunc(func(_ context.Context, o *AipTemplate) {
+		o.MoveTargetOverride = nil
+	})
+}
+
+// Generates a random value for the column using the given faker
+// if faker is nil, a default faker is used
+func (m aipMods) RandomMoveTargetOverride(f *faker.Faker) AipMod {
+	return AipModFunc(func(_ context.Context, o *AipTemplate) {
+		o.MoveTargetOverride = func() string {
+			return random_string(f)
+		}
+	})
+}
+
+// Set the model columns to this value
+func (m aipMods) ReplicationTargetsOverride(val string) AipMod {
+	return AipModFunc(func(_ context.Context, o *AipTemplate) {
+		o.ReplicationTargetsOverride = func() string { return val }
+	})
+}
+
+// Set the Column from the function
+func (m aipMods) ReplicationTargetsOverrideFunc(f func() string) AipMod {
+	return AipModFunc(func(_ context.Context, o *AipTemplate) {
+		o.ReplicationTargetsOverride = f
+	})
+}
+
+// Clear any values for the column
+func (m aipMods) UnsetReplicationTargetsOverride() AipMod {
+	return AipModFunc(func(_ context.Context, o *AipTemplate) {
+		o.ReplicationTargetsOverride = nil
+	})
+}
+
+// Generates a random value for the column using the given faker
+// if faker is nil, a default faker is used
+func (m aipMods) RandomReplicationTargetsOverride(f *faker.Faker) AipMod {
+	return AipModFunc(func(_ context.Context, o *AipTemplate) {
+		o.ReplicationTargetsOverride = func() string {
+			return random_string(f)
+		}
+	})
+}
+
+// Set the model columns to this value
+func (m aipMods) Tags(val string) AipMod {
+	return AipModFunc(func(_ context.Context, o *AipTemplate) {
+		o.Tags = func() string { return val }
+	})
+}
+
+// Set the Column from the function
+func (m aipMods) TagsFunc(f func() string) AipMod {
+	return AipModFunc(func(_ context.Context, o *AipTemplate) {
+		o.Tags = f
+	})
+}
+
+// Clear any values for the column
+func (m aipMods) UnsetTags() AipMod {
+	return AipModFunc(func(_ context.Context, o *AipTemplate) {
+		o.Tags = nil
+	})
+}
+
+// Generates a random value for the column using the given faker
+// if faker is nil, a default faker is used
+func (m aipMods) RandomTags(f *faker.Faker) AipMod {
+	return AipModFunc(func(_ context.Context, o *AipTemplate) {
+		o.Tags = func() string {
+			return random_string(f)
+		}
+	})
+}
+
 func (m aipMods) WithParentsCascading() AipMod {
 	return AipModFunc(func(ctx context.Context, o *AipTemplate) {
 		if isDone, _ := aipWithParentsCascadingCtx.Value(ctx); isDone {
@@ -929,6 +1245,102 @@ func (m aipMods) WithoutAipReplications() AipMod {
 	})
 }
 
+func (m aipMods) WithAipSteps(number int, related *AipStepTemplate) AipMod {
+	return AipModFunc(func(ctx context.Context, o *AipTemplate) {
+		o.r.AipSteps = []*aipRAipStepsR{{
+			number: number,
+			o:      related,
+		}}
+	})
+}
+
+func (m aipMods) WithNewAipSteps(number int, mods ...AipStepMod) AipMod {
+	return AipModFunc(func(ctx context.Context, o *AipTemplate) {
+		related := o.f.NewAipStepWithContext(ctx, mods...)
+		m.WithAipSteps(number, related).Apply(ctx, o)
+	})
+}
+
+func (m aipMods) AddAipSteps(number int, related *AipStepTemplate) AipMod {
+	return AipModFunc(func(ctx context.Context, o *AipTemplate) {
+		o.r.AipSteps = append(o.r.AipSteps, &aipRAipStepsR{
+			number: number,
+			o:      related,
+		})
+	})
+}
+
+func (m aipMods) AddNewAipSteps(number int, mods ...AipStepMod) AipMod {
+	return AipModFunc(func(ctx context.Context, o *AipTemplate) {
+		related := o.f.NewAipStepWithContext(ctx, mods...)
+		m.AddAipSteps(number, related).Apply(ctx, o)
+	})
+}
+
+func (m aipMods) AddExistingAipSteps(existingModels ...*models.AipStep) AipMod {
+	return AipModFunc(func(ctx context.Context, o *AipTemplate) {
+		for _, em := range existingModels {
+			o.r.AipSteps = append(o.r.AipSteps, &aipRAipStepsR{
+				o: o.f.FromExistingAipStep(em),
+			})
+		}
+	})
+}
+
+func (m aipMods) WithoutAipSteps() AipMod {
+	return AipModFunc(func(ctx context.Context, o *AipTemplate) {
+		o.r.AipSteps = nil
+	})
+}
+
+func (m aipMods) WithBatchAips(number int, related *BatchAipTemplate) AipMod {
+	return AipModFunc(func(ctx context.Context, o *AipTemplate) {
+		o.r.BatchAips = []*aipRBatchAipsR{{
+			number: number,
+			o:      related,
+		}}
+	})
+}
+
+func (m aipMods) WithNewBatchAips(number int, mods ...BatchAipMod) AipMod {
+	return AipModFunc(func(ctx context.Context, o *AipTemplate) {
+		related := o.f.NewBatchAipWithContext(ctx, mods...)
+		m.WithBatchAips(number, related).Apply(ctx, o)
+	})
+}
+
+func (m aipMods) AddBatchAips(number int, related *BatchAipTemplate) AipMod {
+	return AipModFunc(func(ctx context.Context, o *AipTemplate) {
+		o.r.BatchAips = append(o.r.BatchAips, &aipRBatchAipsR{
+			number: number,
+			o:      related,
+		})
+	})
+}
+
+func (m aipMods) AddNewBatchAips(number int, mods ...BatchAipMod) AipMod {
+	return AipModFunc(func(ctx context.Context, o *AipTemplate) {
+		related := o.f.NewBatchAipWithContext(ctx, mods...)
+		m.AddBatchAips(number, related).Apply(ctx, o)
+	})
+}
+
+func (m aipMods) AddExistingBatchAips(existingModels ...*models.BatchAip) AipMod {
+	return AipModFunc(func(ctx context.Context, o *AipTemplate) {
+		for _, em := range existingModels {
+			o.r.BatchAips = append(o.r.BatchAips, &aipRBatchAipsR{
+				o: o.f.FromExistingBatchAip(em),
+			})
+		}
+	})
+}
+
+func (m aipMods) WithoutBatchAips() AipMod {
+	return AipModFunc(func(ctx context.Context, o *AipTemplate) {
+		o.r.BatchAips = nil
+	})
+}
+
 func (m aipMods) WithErrors(number int, related *ErrorTemplate) AipMod {
 	return AipModFunc(func(ctx context.Context, o *AipTemplate) {
 		o.r.Errors = []*aipRErrorsR{{
@@ -12,12 +12,30 @@ var (
 	aipReplicationWithParentsCascadingCtx = newContextual[bool]("aipReplicationWithParentsCascading")
 	aipReplicationRelAipCtx               = newContextual[bool]("aip_replication.aips.fk_aip_replication_0")
 
+	// Relationship Contexts for aip_steps
+	aipStepWithParentsCascadingCtx = newContextual[bool]("aipStepWithParentsCascading")
+	aipStepRelAipCtx               = newContextual[bool]("aip_steps.aips.fk_aip_steps_0")
+
 	// Relationship Contexts for aips
 	aipWithParentsCascadingCtx = newContextual[bool]("aipWithParentsCascading")
 	aipRelAipReplicationsCtx   = newContextual[bool]("aip_replication.aips.fk_aip_replication_0")
+	aipRelAipStepsCtx          = newContextual[bool]("aip_steps.aips.fk_aip_steps_0")
+	aipRelBatchAipsCtx         = newContextual[bool]("aips.batch_aips.fk_batch_aips_0")
 	aipRelErrorsCtx            = newContextual[bool]("aips.errors.fk_errors_0")
 	aipRelEventsCtx            = newContextual[bool]("aips.events.fk_events_0")
 
+	// Relationship Contexts for batch_aips
+	batchAipWithParentsCascadingCtx = newContextual[bool]("batchAipWithParentsCascading")
+	batchAipRelAipCtx               = newContextual[bool]("aips.batch_aips.fk_batch_aips_0")
+	batchAipRelBatchCtx             = newContextual[bool]("batch_aips.batches.fk_batch_aips_1")
+
+	// Relationship Contexts for batch_workflow_aips
+	batchWorkflowAipWithParentsCascadingCtx = newContextual[bool]("batchWorkflowAipWithParentsCascading")
+
+	// Relationship Contexts for batches
+	batchWithParentsCascadingCtx = newContextual[bool]("batchWithParentsCascading")
+	batchRelBatchAipsCtx         = newContextual[bool]("batch_aips.batches.fk_batch_aips_1")
+
 	// Relationship Contexts for errors
 	errorWithParentsCascadingCtx = newContextual[bool]("errorWithParentsCascading")
 	errorRelAipCtx               = newContextual[bool]("aips.errors.fk_errors_0")
@@ -25,6 +43,20 @@ var (
 	// Relationship Contexts for events
 	eventWithParentsCascadingCtx = newContextual[bool]("eventWithParentsCascading")
 	eventRelAipCtx               = newContextual[bool]("aips.events.fk_events_0")
+
+	// Relationship Contexts for location_leases
+	locationLeaseWithParentsCascadingCtx = newContextual[bool]("locationLeaseWithParentsCascading")
+
+	// Relationship Contexts for location_transfers
+	locationTransferWithParentsCascadingCtx = newContextual[bool]("locationTransferWithParentsCascading")
+
+	// Relationship Contexts for plan_items
+	planItemWithParentsCascadingCtx = newContextual[bool]("planItemWithParentsCascading")
+	planItemRelPlanCtx              = newContextual[bool]("plan_items.plans.fk_plan_items_0")
+
+	// Relationship Contexts for plans
+	planWithParentsCascadingCtx = newContextual[bool]("planWithParentsCascading")
+	planRelPlanItemsCtx         = newContextual[bool]("plan_items.plans.fk_plan_items_0")
 )
 
 // Contextual is a convienience wrapper around context.WithValue and context.Value
@@ -11,10 +11,18 @@ import (
 )
 
 type Factory struct {
-	baseAipReplicationMods AipReplicationModSlice
-	baseAipMods            AipModSlice
-	baseErrorMods          ErrorModSlice
-	baseEventMods          EventModSlice
+	baseAipReplicationMods   AipReplicationModSlice
+	baseAipStepMods          AipStepModSlice
+	baseAipMods              AipModSlice
+	baseBatchAipMods         BatchAipModSlice
+	baseBatchWorkflowAipMods BatchWorkflowAipModSlice
+	baseBatchMods            BatchModSlice
+	baseErrorMods            ErrorModSlice
+	baseEventMods            EventModSlice
+	baseLocationLeaseMods    LocationLeaseModSlice
+	baseLocationTransferMods LocationTransferModSlice
+	basePlanItemMods         PlanItemModSlice
+	basePlanMods             PlanModSlice
 }
 
 func New() *Factory {
@@ -46,6 +54,9 @@ func (f *Factory) FromExistingAipReplication(m *models.AipReplication) *AipRepli
 	o.ReplicaUUID = func() null.Val[string] { return m.ReplicaUUID }
 	o.Status = func() string { return m.Status }
 	o.Attempt = func() int64 { return m.Attempt }
+	o.FixityStatus = func() null.Val[string] { return m.FixityStatus }
+	o.FixityCheckedAt = func() null.Val[string] { return m.FixityCheckedAt }
+	o.Rule = func() string { return m.Rule }
 
 	ctx := context.Background()
 	if m.R.Aip != nil {
@@ -55,6 +66,41 @@ func (f *Factory) FromExistingAipReplication(m *models.AipReplication) *AipRepli
 	return o
 }
 
+func (f *Factory) NewAipStep(mods ...AipStepMod) *AipStepTemplate {
+	return f.NewAipStepWithContext(context.Background(), mods...)
+}
+
+func (f *Factory) NewAipStepWithContext(ctx context.Context, mods ...AipStepMod) *AipStepTemplate {
+	o := &AipStepTemplate{f: f}
+
+	if f != nil {
+		f.baseAipStepMods.Apply(ctx, o)
+	}
+
+	AipStepModSlice(mods).Apply(ctx, o)
+
+	return o
+}
+
+func (f *Factory) FromExistingAipStep(m *models.AipStep) *AipStepTemplate {
+	o := &AipStepTemplate{f: f, alreadyPersisted: true}
+
+	o.ID = func() int64 { return m.ID }
+	o.AipID = func() int64 { return m.AipID }
+	o.Step = func() string { return m.Step }
+	o.Status = func() string { return m.Status }
+	o.Details = func() null.Val[string] { return m.Details }
+	o.StartedAt = func() string { return m.StartedAt }
+	o.CompletedAt = func() null.Val[string] { return m.CompletedAt }
+
+	ctx := context.Background()
+	if m.R.Aip != nil {
+		AipStepMods.WithExistingAip(m.R.Aip).Apply(ctx, o)
+	}
+
+	return o
+}
+
 func (f *Factory) NewAip(mods ...AipMod) *AipTemplate {
 	return f.NewAipWithContext(context.Background(), mods...)
 }
@@ -86,11 +132,23 @@ func (f *Factory) FromExistingAip(m *models.Aip) *AipTemplate {
 	o.CurrentLocation = func() null.Val[string] { return m.CurrentLocation }
 	o.Size = func() null.Val[int64] { return m.Size }
 	o.LocationUUID = func() null.Val[string] { return m.LocationUUID }
+	o.Priority = func() int64 { return m.Priority }
+	o.SourceLocationUUID = func() string { return m.SourceLocationUUID }
+	o.MoveTargetLocationUUID = func() string { return m.MoveTargetLocationUUID }
+	o.MoveTargetOverride = func() string { return m.MoveTargetOverride }
+	o.ReplicationTargetsOverride = func() string { return m.ReplicationTargetsOverride }
+	o.Tags = func() string { return m.Tags }
 
 	ctx := context.Background()
 	if len(m.R.AipReplications) > 0 {
 		AipMods.AddExistingAipReplications(m.R.AipReplications...).Apply(ctx, o)
 	}
+	if len(m.R.AipSteps) > 0 {
+		AipMods.AddExistingAipSteps(m.R.AipSteps...).Apply(ctx, o)
+	}
+	if len(m.R.BatchAips) > 0 {
+		AipMods.AddExistingBatchAips(m.R.BatchAips...).Apply(ctx, o)
+	}
 	if len(m.R.Errors) > 0 {
 		AipMods.AddExistingErrors(m.R.Errors...).Apply(ctx, o)
 	}
@@ -101,6 +159,102 @@ func (f *Factory) FromExistingAip(m *models.Aip) *AipTemplate {
 	return o
 }
 
+func (f *Factory) NewBatchAip(mods ...BatchAipMod) *BatchAipTemplate {
+	return f.NewBatchAipWithContext(context.Background(), mods...)
+}
+
+func (f *Factory) NewBatchAipWithContext(ctx context.Context, mods ...BatchAipMod) *BatchAipTemplate {
+	o := &BatchAipTemplate{f: f}
+
+	if f != nil {
+		f.baseBatchAipMods.Apply(ctx, o)
+	}
+
+	BatchAipModSlice(mods).Apply(ctx, o)
+
+	return o
+}
+
+func (f *Factory) FromExistingBatchAip(m *models.BatchAip) *BatchAipTemplate {
+	o := &BatchAipTemplate{f: f, alreadyPersisted: true}
+
+	o.ID = func() int64 { return m.ID }
+	o.BatchID = func() int64 { return m.BatchID }
+	o.AipID = func() int64 { return m.AipID }
+	o.Status = func() string { return m.Status }
+
+	ctx := context.Background()
+	if m.R.Aip != nil {
+		BatchAipMods.WithExistingAip(m.R.Aip).Apply(ctx, o)
+	}
+	if m.R.Batch != nil {
+		BatchAipMods.WithExistingBatch(m.R.Batch).Apply(ctx, o)
+	}
+
+	return o
+}
+
+func (f *Factory) NewBatchWorkflowAip(mods ...BatchWorkflowAipMod) *BatchWorkflowAipTemplate {
+	return f.NewBatchWorkflowAipWithContext(context.Background(), mods...)
+}
+
+func (f *Factory) NewBatchWorkflowAipWithContext(ctx context.Context, mods ...BatchWorkflowAipMod) *BatchWorkflowAipTemplate {
+	o := &BatchWorkflowAipTemplate{f: f}
+
+	if f != nil {
+		f.baseBatchWorkflowAipMods.Apply(ctx, o)
+	}
+
+	BatchWorkflowAipModSlice(mods).Apply(ctx, o)
+
+	return o
+}
+
+func (f *Factory) FromExistingBatchWorkflowAip(m *models.BatchWorkflowAip) *BatchWorkflowAipTemplate {
+	o := &BatchWorkflowAipTemplate{f: f, alreadyPersisted: true}
+
+	o.ID = func() int64 { return m.ID }
+	o.BatchID = func() string { return m.BatchID }
+	o.Position = func() int64 { return m.Position }
+	o.AipUUID = func() string { return m.AipUUID }
+
+	return o
+}
+
+func (f *Factory) NewBatch(mods ...BatchMod) *BatchTemplate {
+	return f.NewBatchWithContext(context.Background(), mods...)
+}
+
+func (f *Factory) NewBatchWithContext(ctx context.Context, mods ...BatchMod) *BatchTemplate {
+	o := &BatchTemplate{f: f}
+
+	if f != nil {
+		f.baseBatchMods.Apply(ctx, o)
+	}
+
+	BatchModSlice(mods).Apply(ctx, o)
+
+	return o
+}
+
+func (f *Factory) FromExistingBatch(m *models.Batch) *BatchTemplate {
+	o := &BatchTemplate{f: f, alreadyPersisted: true}
+
+	o.ID = func() int64 { return m.ID }
+	o.Name = func() string { return m.Name }
+	o.Operation = func() string { return m.Operation }
+	o.CreatedAt = func() string { return m.CreatedAt }
+	o.InputChecksum = func() string { return m.InputChecksum }
+	o.Config = func() string { return m.Config }
+
+	ctx := context.Background()
+	if len(m.R.BatchAips) > 0 {
+		BatchMods.AddExistingBatchAips(m.R.BatchAips...).Apply(ctx, o)
+	}
+
+	return o
+}
+
 func (f *Factory) NewError(mods ...ErrorMod) *ErrorTemplate {
 	return f.NewErrorWithContext(context.Background(), mods...)
 }
@@ -160,6 +314,8 @@ func (f *Factory) FromExistingEvent(m *models.Event) *EventTemplate {
 	o.TotalDuration = func() null.Val[string] { return m.TotalDuration }
 	o.TotalDurationNanoseconds = func() null.Val[int64] { return m.TotalDurationNanoseconds }
 	o.Details = func() null.Val[string] { return m.Details }
+	o.Success = func() bool { return m.Success }
+	o.EndedAt = func() null.Val[int64] { return m.EndedAt }
 
 	ctx := context.Background()
 	if m.R.Aip != nil {
@@ -169,6 +325,132 @@ func (f *Factory) FromExistingEvent(m *models.Event) *EventTemplate {
 	return o
 }
 
+func (f *Factory) NewLocationLease(mods ...LocationLeaseMod) *LocationLeaseTemplate {
+	return f.NewLocationLeaseWithContext(context.Background(), mods...)
+}
+
+func (f *Factory) NewLocationLeaseWithContext(ctx context.Context, mods ...LocationLeaseMod) *LocationLeaseTemplate {
+	o := &LocationLeaseTemplate{f: f}
+
+	if f != nil {
+		f.baseLocationLeaseMods.Apply(ctx, o)
+	}
+
+	LocationLeaseModSlice(mods).Apply(ctx, o)
+
+	return o
+}
+
+func (f *Factory) FromExistingLocationLease(m *models.LocationLease) *LocationLeaseTemplate {
+	o := &LocationLeaseTemplate{f: f, alreadyPersisted: true}
+
+	o.ID = func() int64 { return m.ID }
+	o.LocationUUID = func() string { return m.LocationUUID }
+	o.Holder = func() string { return m.Holder }
+	o.AcquiredAt = func() string { return m.AcquiredAt }
+	o.Size = func() int64 { return m.Size }
+	o.Role = func() string { return m.Role }
+
+	return o
+}
+
+func (f *Factory) NewLocationTransfer(mods ...LocationTransferMod) *LocationTransferTemplate {
+	return f.NewLocationTransferWithContext(context.Background(), mods...)
+}
+
+func (f *Factory) NewLocationTransferWithContext(ctx context.Context, mods ...LocationTransferMod) *LocationTransferTemplate {
+	o := &LocationTransferTemplate{f: f}
+
+	if f != nil {
+		f.baseLocationTransferMods.Apply(ctx, o)
+	}
+
+	LocationTransferModSlice(mods).Apply(ctx, o)
+
+	return o
+}
+
+func (f *Factory) FromExistingLocationTransfer(m *models.LocationTransfer) *LocationTransferTemplate {
+	o := &LocationTransferTemplate{f: f, alreadyPersisted: true}
+
+	o.ID = func() int64 { return m.ID }
+	o.LocationUUID = func() string { return m.LocationUUID }
+	o.AipUUID = func() string { return m.AipUUID }
+	o.Size = func() int64 { return m.Size }
+	o.Day = func() string { return m.Day }
+	o.TransferredAt = func() string { return m.TransferredAt }
+
+	return o
+}
+
+func (f *Factory) NewPlanItem(mods ...PlanItemMod) *PlanItemTemplate {
+	return f.NewPlanItemWithContext(context.Background(), mods...)
+}
+
+func (f *Factory) NewPlanItemWithContext(ctx context.Context, mods ...PlanItemMod) *PlanItemTemplate {
+	o := &PlanItemTemplate{f: f}
+
+	if f != nil {
+		f.basePlanItemMods.Apply(ctx, o)
+	}
+
+	PlanItemModSlice(mods).Apply(ctx, o)
+
+	return o
+}
+
+func (f *Factory) FromExistingPlanItem(m *models.PlanItem) *PlanItemTemplate {
+	o := &PlanItemTemplate{f: f, alreadyPersisted: true}
+
+	o.ID = func() int64 { return m.ID }
+	o.PlanID = func() int64 { return m.PlanID }
+	o.AipUUID = func() string { return m.AipUUID }
+	o.Classification = func() string { return m.Classification }
+	o.LocationUUID = func() string { return m.LocationUUID }
+	o.TargetLocationUUID = func() string { return m.TargetLocationUUID }
+	o.Size = func() int64 { return m.Size }
+
+	ctx := context.Background()
+	if m.R.Plan != nil {
+		PlanItemMods.WithExistingPlan(m.R.Plan).Apply(ctx, o)
+	}
+
+	return o
+}
+
+func (f *Factory) NewPlan(mods ...PlanMod) *PlanTemplate {
+	return f.NewPlanWithContext(context.Background(), mods...)
+}
+
+func (f *Factory) NewPlanWithContext(ctx context.Context, mods ...PlanMod) *PlanTemplate {
+	o := &PlanTemplate{f: f}
+
+	if f != nil {
+		f.basePlanMods.Apply(ctx, o)
+	}
+
+	PlanModSlice(mods).Apply(ctx, o)
+
+	return o
+}
+
+func (f *Factory) FromExistingPlan(m *models.Plan) *PlanTemplate {
+	o := &PlanTemplate{f: f, alreadyPersisted: true}
+
+	o.ID = func() int64 { return m.ID }
+	o.Operation = func() string { return m.Operation }
+	o.CreatedAt = func() string { return m.CreatedAt }
+	o.TotalAips = func() int64 { return m.TotalAips }
+	o.TotalBytes = func() int64 { return m.TotalBytes }
+
+	ctx := context.Background()
+	if len(m.R.PlanItems) > 0 {
+		PlanMods.AddExistingPlanItems(m.R.PlanItems...).Apply(ctx, o)
+	}
+
+	return o
+}
+
 func (f *Factory) ClearBaseAipReplicationMods() {
 	f.baseAipReplicationMods = nil
 }
@@ -177,6 +459,14 @@ func (f *Factory) AddBaseAipReplicationMod(mods ...AipReplicationMod) {
 	f.baseAipReplicationMods = append(f.baseAipReplicationMods, mods...)
 }
 
+func (f *Factory) ClearBaseAipStepMods() {
+	f.baseAipStepMods = nil
+}
+
+func (f *Factory) AddBaseAipStepMod(mods ...AipStepMod) {
+	f.baseAipStepMods = append(f.baseAipStepMods, mods...)
+}
+
 func (f *Factory) ClearBaseAipMods() {
 	f.baseAipMods = nil
 }
@@ -185,6 +475,30 @@ func (f *Factory) AddBaseAipMod(mods ...AipMod) {
 	f.baseAipMods = append(f.baseAipMods, mods...)
 }
 
+func (f *Factory) ClearBaseBatchAipMods() {
+	f.baseBatchAipMods = nil
+}
+
+func (f *Factory) AddBaseBatchAipMod(mods ...BatchAipMod) {
+	f.baseBatchAipMods = append(f.baseBatchAipMods, mods...)
+}
+
+func (f *Factory) ClearBaseBatchWorkflowAipMods() {
+	f.baseBatchWorkflowAipMods = nil
+}
+
+func (f *Factory) AddBaseBatchWorkflowAipMod(mods ...BatchWorkflowAipMod) {
+	f.baseBatchWorkflowAipMods = append(f.baseBatchWorkflowAipMods, mods...)
+}
+
+func (f *Factory) ClearBaseBatchMods() {
+	f.baseBatchMods = nil
+}
+
+func (f *Factory) AddBaseBatchMod(mods ...BatchMod) {
+	f.baseBatchMods = append(f.baseBatchMods, mods...)
+}
+
 func (f *Factory) ClearBaseErrorMods() {
 	f.baseErrorMods = nil
 }
@@ -200,3 +514,35 @@ func (f *Factory) ClearBaseEventMods() {
 func (f *Factory) AddBaseEventMod(mods ...EventMod) {
 	f.baseEventMods = append(f.baseEventMods, mods...)
 }
+
+func (f *Factory) ClearBaseLocationLeaseMods() {
+	f.baseLocationLeaseMods = nil
+}
+
+func (f *Factory) AddBaseLocationLeaseMod(mods ...LocationLeaseMod) {
+	f.baseLocationLeaseMods = append(f.baseLocationLeaseMods, mods...)
+}
+
+func (f *Factory) ClearBaseLocationTransferMods() {
+	f.baseLocationTransferMods = nil
+}
+
+func (f *Factory) AddBaseLocationTransferMod(mods ...LocationTransferMod) {
+	f.baseLocationTransferMods = append(f.baseLocationTransferMods, mods...)
+}
+
+func (f *Factory) ClearBasePlanItemMods() {
+	f.basePlanItemMods = nil
+}
+
+func (f *Factory) AddBasePlanItemMod(mods ...PlanItemMod) {
+	f.basePlanItemMods = append(f.basePlanItemMods, mods...)
+}
+
+func (f *Factory) ClearBasePlanMods() {
+	f.basePlanMods = nil
+}
+
+func (f *Factory) AddBasePlanMod(mods ...PlanMod) {
+	f.basePlanMods = append(f.basePlanMods, mods...)
+}
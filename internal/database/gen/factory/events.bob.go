@@ -44,6 +44,8 @@ type EventTemplate struct {
 	TotalDuration            func() null.Val[string]
 	TotalDurationNanoseconds func() null.Val[int64]
 	Details                  func() null.Val[string]
+	Success                  func() bool
+	EndedAt                  func() null.Val[int64]
 
 	r eventR
 	f *Factory
@@ -114,6 +116,14 @@ func (o EventTemplate) BuildSetter() *models.EventSetter {
 		val := o.Details()
 		m.Details = omitnull.FromNull(val)
 	}
+	if o.Success != nil {
+		val := o.Success()
+		m.Success = omit.From(val)
+	}
+	if o.EndedAt != nil {
+		val := o.EndedAt()
+		m.EndedAt = omitnull.FromNull(val)
+	}
 
 	return m
 }
@@ -160,6 +170,12 @@ func (o EventTemplate) Build() *models.Event {
 	if o.Details != nil {
 		m.Details = o.Details()
 	}
+	if o.Success != nil {
+		m.Success = o.Success()
+	}
+	if o.EndedAt != nil {
+		m.EndedAt = o.EndedAt()
+	}
 
 	o.setModelRels(m)
 
@@ -323,6 +339,8 @@ func (m eventMods) RandomizeAllColumns(f *faker.Faker) EventMod {
 		EventMods.RandomTotalDuration(f),
 		EventMods.RandomTotalDurationNanoseconds(f),
 		EventMods.RandomDetails(f),
+		EventMods.RandomSuccess(f),
+		EventMods.RandomEndedAt(f),
 	}
 }
 
@@ -640,6 +658,90 @@ func (m eventMods) RandomDetailsNotNull(f *faker.Faker) EventMod {
 	})
 }
 
+// Set the model columns to this value
+func (m eventMods) Success(val bool) EventMod {
+	return EventModFunc(func(_ context.Context, o *EventTemplate) {
+		o.Success = func() bool { return val }
+	})
+}
+
+// Set the Column from the function
+func (m eventMods) SuccessFunc(f func() bool) EventMod {
+	return EventModFunc(func(_ context.Context, o *EventTemplate) {
+		o.Success = f
+	})
+}
+
+// Clear any values for the column
+func (m eventMods) UnsetSuccess() EventMod {
+	return EventModFunc(func(_ context.Context, o *EventTemplate) {
+		o.Success = nil
+	})
+}
+
+// Generates a random value for the column using the given faker
+// if faker is nil, a default faker is used
+func (m eventMods) RandomSuccess(f *faker.Faker) EventMod {
+	return EventModFunc(func(_ context.Context, o *EventTemplate) {
+		o.Success = func() bool {
+			return random_bool(f)
+		}
+	})
+}
+
+// Set the model columns to this value
+func (m eventMods) EndedAt(val null.Val[int64]) EventMod {
+	return EventModFunc(func(_ context.Context, o *EventTemplate) {
+		o.EndedAt = func() null.Val[int64] { return val }
+	})
+}
+
+// Set the Column from the function
+func (m eventMods) EndedAtFunc(f func() null.Val[int64]) EventMod {
+	return EventModFunc(func(_ context.Context, o *EventTemplate) {
+		o.EndedAt = f
+	})
+}
+
+// Clear any values for the column
+func (m eventMods) UnsetEndedAt() EventMod {
+	return EventModFunc(func(_ context.Context, o *EventTemplate) {
+		o.EndedAt = nil
+	})
+}
+
+// Generates a random value for the column using the given faker
+// if faker is nil, a default faker is used
+// The generated value is sometimes null
+func (m eventMods) RandomEndedAt(f *faker.Faker) EventMod {
+	return EventModFunc(func(_ context.Context, o *EventTemplate) {
+		o.EndedAt = func() null.Val[int64] {
+			if f == nil {
+				f = &defaultFaker
+			}
+
+			val := random_int64(f)
+			return null.From(val)
+		}
+	})
+}
+
+// Generates a random value for the column using the given faker
+// if faker is nil, a default faker is used
+// The generated value is never null
+func (m eventMods) RandomEndedAtNotNull(f *faker.Faker) EventMod {
+	return EventModFunc(func(_ context.Context, o *EventTemplate) {
+		o.EndedAt = func() null.Val[int64] {
+			if f == nil {
+				f = &defaultFaker
+			}
+
+			val := random_int64(f)
+			return null.From(val)
+		}
+	})
+}
+
 func (m eventMods) WithParentsCascading() EventMod {
 	return EventModFunc(func(ctx context.Context, o *EventTemplate) {
 		if isDone, _ := eventWithParentsCascadingCtx.Value(ctx); isDone {
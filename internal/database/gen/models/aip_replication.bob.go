@@ -24,12 +24,15 @@ import (
 
 // AipReplication is an object representing the database table.
 type AipReplication struct {
-	ID           int64            `db:"id,pk" `
-	AipID        int64            `db:"aip_id" `
-	LocationUUID null.Val[string] `db:"location_uuid" `
-	ReplicaUUID  null.Val[string] `db:"replica_uuid" `
-	Status       string           `db:"status" `
-	Attempt      int64            `db:"attempt" `
+	ID              int64            `db:"id,pk" `
+	AipID           int64            `db:"aip_id" `
+	LocationUUID    null.Val[string] `db:"location_uuid" `
+	ReplicaUUID     null.Val[string] `db:"replica_uuid" `
+	Status          string           `db:"status" `
+	Attempt         int64            `db:"attempt" `
+	FixityStatus    null.Val[string] `db:"fixity_status" `
+	FixityCheckedAt null.Val[string] `db:"fixity_checked_at" `
+	Rule            string           `db:"rule" `
 
 	R aipReplicationR `db:"-" `
 }
@@ -52,27 +55,33 @@ type aipReplicationR struct {
 func buildAipReplicationColumns(alias string) aipReplicationColumns {
 	return aipReplicationColumns{
 		ColumnsExpr: expr.NewColumnsExpr(
-			"id", "aip_id", "location_uuid", "replica_uuid", "status", "attempt",
+			"id", "aip_id", "location_uuid", "replica_uuid", "status", "attempt", "fixity_status", "fixity_checked_at", "rule",
 		).WithParent("aip_replication"),
-		tableAlias:   alias,
-		ID:           sqlite.Quote(alias, "id"),
-		AipID:        sqlite.Quote(alias, "aip_id"),
-		LocationUUID: sqlite.Quote(alias, "location_uuid"),
-		ReplicaUUID:  sqlite.Quote(alias, "replica_uuid"),
-		Status:       sqlite.Quote(alias, "status"),
-		Attempt:      sqlite.Quote(alias, "attempt"),
+		tableAlias:      alias,
+		ID:              sqlite.Quote(alias, "id"),
+		AipID:           sqlite.Quote(alias, "aip_id"),
+		LocationUUID:    sqlite.Quote(alias, "location_uuid"),
+		ReplicaUUID:     sqlite.Quote(alias, "replica_uuid"),
+		Status:          sqlite.Quote(alias, "status"),
+		Attempt:         sqlite.Quote(alias, "attempt"),
+		FixityStatus:    sqlite.Quote(alias, "fixity_status"),
+		FixityCheckedAt: sqlite.Quote(alias, "fixity_checked_at"),
+		Rule:            sqlite.Quote(alias, "rule"),
 	}
 }
 
 type aipReplicationColumns struct {
 	expr.ColumnsExpr
-	tableAlias   string
-	ID           sqlite.Expression
-	AipID        sqlite.Expression
-	LocationUUID sqlite.Expression
-	ReplicaUUID  sqlite.Expression
-	Status       sqlite.Expression
-	Attempt      sqlite.Expression
+	tableAlias      string
+	ID              sqlite.Expression
+	AipID           sqlite.Expression
+	LocationUUID    sqlite.Expression
+	ReplicaUUID     sqlite.Expression
+	Status          sqlite.Expression
+	Attempt         sqlite.Expression
+	FixityStatus    sqlite.Expression
+	FixityCheckedAt sqlite.Expression
+	Rule            sqlite.Expression
 }
 
 func (c aipReplicationColumns) Alias() string {
@@ -87,16 +96,19 @@ func (aipReplicationColumns) AliasedAs(alias string) aipReplicationColumns {
 // All values are optional, and do not have to be set
 // Generated columns are not included
 type AipReplicationSetter struct {
-	ID           omit.Val[int64]      `db:"id,pk" `
-	AipID        omit.Val[int64]      `db:"aip_id" `
-	LocationUUID omitnull.Val[string] `db:"location_uuid" `
-	ReplicaUUID  omitnull.Val[string] `db:"replica_uuid" `
-	Status       omit.Val[string]     `db:"status" `
-	Attempt      omit.Val[int64]      `db:"attempt" `
+	ID              omit.Val[int64]      `db:"id,pk" `
+	AipID           omit.Val[int64]      `db:"aip_id" `
+	LocationUUID    omitnull.Val[string] `db:"location_uuid" `
+	ReplicaUUID     omitnull.Val[string] `db:"replica_uuid" `
+	Status          omit.Val[string]     `db:"status" `
+	Attempt         omit.Val[int64]      `db:"attempt" `
+	FixityStatus    omitnull.Val[string] `db:"fixity_status" `
+	FixityCheckedAt omitnull.Val[string] `db:"fixity_checked_at" `
+	Rule            omit.Val[string]     `db:"rule" `
 }
 
 func (s AipReplicationSetter) SetColumns() []string {
-	vals := make([]string, 0, 6)
+	vals := make([]string, 0, 9)
 	if s.ID.IsValue() {
 		vals = append(vals, "id")
 	}
@@ -115,6 +127,15 @@ func (s AipReplicationSetter) SetColumns() []string {
 	if s.Attempt.IsValue() {
 		vals = append(vals, "attempt")
 	}
+	if !s.FixityStatus.IsUnset() {
+		vals = append(vals, "fixity_status")
+	}
+	if !s.FixityCheckedAt.IsUnset() {
+		vals = append(vals, "fixity_checked_at")
+	}
+	if s.Rule.IsValue() {
+		vals = append(vals, "rule")
+	}
 	return vals
 }
 
@@ -137,6 +158,15 @@ func (s AipReplicationSetter) Overwrite(t *AipReplication) {
 	if s.Attempt.IsValue() {
 		t.Attempt = s.Attempt.MustGet()
 	}
+	if !s.FixityStatus.IsUnset() {
+		t.FixityStatus = s.FixityStatus.MustGetNull()
+	}
+	if !s.FixityCheckedAt.IsUnset() {
+		t.FixityCheckedAt = s.FixityCheckedAt.MustGetNull()
+	}
+	if s.Rule.IsValue() {
+		t.Rule = s.Rule.MustGet()
+	}
 }
 
 func (s *AipReplicationSetter) Apply(q *dialect.InsertQuery) {
@@ -153,7 +183,7 @@ func (s *AipReplicationSetter) Apply(q *dialect.InsertQuery) {
 	}
 
 	q.AppendValues(bob.ExpressionFunc(func(ctx context.Context, w io.Writer, d bob.Dialect, start int) ([]any, error) {
-		vals := make([]bob.Expression, 0, 6)
+		vals := make([]bob.Expression, 0, 9)
 		if s.ID.IsValue() {
 			vals = append(vals, sqlite.Arg(s.ID.MustGet()))
 		}
@@ -178,6 +208,18 @@ func (s *AipReplicationSetter) Apply(q *dialect.InsertQuery) {
 			vals = append(vals, sqlite.Arg(s.Attempt.MustGet()))
 		}
 
+		if !s.FixityStatus.IsUnset() {
+			vals = append(vals, sqlite.Arg(s.FixityStatus.MustGetNull()))
+		}
+
+		if !s.FixityCheckedAt.IsUnset() {
+			vals = append(vals, sqlite.Arg(s.FixityCheckedAt.MustGetNull()))
+		}
+
+		if s.Rule.IsValue() {
+			vals = append(vals, sqlite.Arg(s.Rule.MustGet()))
+		}
+
 		if len(vals) == 0 {
 			vals = append(vals, sqlite.Arg(nil))
 		}
@@ -191,7 +233,7 @@ func (s AipReplicationSetter) UpdateMod() bob.Mod[*dialect.UpdateQuery] {
 }
 
 func (s AipReplicationSetter) Expressions(prefix ...string) []bob.Expression {
-	exprs := make([]bob.Expression, 0, 6)
+	exprs := make([]bob.Expression, 0, 9)
 
 	if s.ID.IsValue() {
 		exprs = append(exprs, expr.Join{Sep: " = ", Exprs: []bob.Expression{
@@ -235,6 +277,27 @@ func (s AipReplicationSetter) Expressions(prefix ...string) []bob.Expression {
 		}})
 	}
 
+	if !s.FixityStatus.IsUnset() {
+		exprs = append(exprs, expr.Join{Sep: " = ", Exprs: []bob.Expression{
+			sqlite.Quote(append(prefix, "fixity_status")...),
+			sqlite.Arg(s.FixityStatus),
+		}})
+	}
+
+	if !s.FixityCheckedAt.IsUnset() {
+		exprs = append(exprs, expr.Join{Sep: " = ", Exprs: []bob.Expression{
+			sqlite.Quote(append(prefix, "fixity_checked_at")...),
+			sqlite.Arg(s.FixityCheckedAt),
+		}})
+	}
+
+	if s.Rule.IsValue() {
+		exprs = append(exprs, expr.Join{Sep: " = ", Exprs: []bob.Expression{
+			sqlite.Quote(append(prefix, "rule")...),
+			sqlite.Arg(s.Rule),
+		}})
+	}
+
 	return exprs
 }
 
@@ -529,12 +592,15 @@ func (aipReplication0 *AipReplication) AttachAip(ctx context.Context, exec bob.E
 }
 
 type aipReplicationWhere[Q sqlite.Filterable] struct {
-	ID           sqlite.WhereMod[Q, int64]
-	AipID        sqlite.WhereMod[Q, int64]
-	LocationUUID sqlite.WhereNullMod[Q, string]
-	ReplicaUUID  sqlite.WhereNullMod[Q, string]
-	Status       sqlite.WhereMod[Q, string]
-	Attempt      sqlite.WhereMod[Q, int64]
+	ID              sqlite.WhereMod[Q, int64]
+	AipID           sqlite.WhereMod[Q, int64]
+	LocationUUID    sqlite.WhereNullMod[Q, string]
+	ReplicaUUID     sqlite.WhereNullMod[Q, string]
+	Status          sqlite.WhereMod[Q, string]
+	Attempt         sqlite.WhereMod[Q, int64]
+	FixityStatus    sqlite.WhereNullMod[Q, string]
+	FixityCheckedAt sqlite.WhereNullMod[Q, string]
+	Rule            sqlite.WhereMod[Q, string]
 }
 
 func (aipReplicationWhere[Q]) AliasedAs(alias string) aipReplicationWhere[Q] {
@@ -543,12 +609,15 @@ func (aipReplicationWhere[Q]) AliasedAs(alias string) aipReplicationWhere[Q] {
 
 func buildAipReplicationWhere[Q sqlite.Filterable](cols aipReplicationColumns) aipReplicationWhere[Q] {
 	return aipReplicationWhere[Q]{
-		ID:           sqlite.Where[Q, int64](cols.ID),
-		AipID:        sqlite.Where[Q, int64](cols.AipID),
-		LocationUUID: sqlite.WhereNull[Q, string](cols.LocationUUID),
-		ReplicaUUID:  sqlite.WhereNull[Q, string](cols.ReplicaUUID),
-		Status:       sqlite.Where[Q, string](cols.Status),
-		Attempt:      sqlite.Where[Q, int64](cols.Attempt),
+		ID:              sqlite.Where[Q, int64](cols.ID),
+		AipID:           sqlite.Where[Q, int64](cols.AipID),
+		LocationUUID:    sqlite.WhereNull[Q, string](cols.LocationUUID),
+		ReplicaUUID:     sqlite.WhereNull[Q, string](cols.ReplicaUUID),
+		Status:          sqlite.Where[Q, string](cols.Status),
+		Attempt:         sqlite.Where[Q, int64](cols.Attempt),
+		FixityStatus:    sqlite.WhereNull[Q, string](cols.FixityStatus),
+		FixityCheckedAt: sqlite.WhereNull[Q, string](cols.FixityCheckedAt),
+		Rule:            sqlite.Where[Q, string](cols.Rule),
 	}
 }
 
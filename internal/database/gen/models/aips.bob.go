@@ -24,18 +24,24 @@ import (
 
 // Aip is an object representing the database table.
 type Aip struct {
-	ID              int64            `db:"id,pk" `
-	UUID            string           `db:"uuid" `
-	Status          string           `db:"status" `
-	Found           bool             `db:"found" `
-	FixityRun       bool             `db:"fixity_run" `
-	Moved           bool             `db:"moved" `
-	Cleaned         bool             `db:"cleaned" `
-	Replicated      bool             `db:"replicated" `
-	ReIndexed       bool             `db:"re_indexed" `
-	CurrentLocation null.Val[string] `db:"current_location" `
-	Size            null.Val[int64]  `db:"size" `
-	LocationUUID    null.Val[string] `db:"location_uuid" `
+	ID                         int64            `db:"id,pk" `
+	UUID                       string           `db:"uuid" `
+	Status                     string           `db:"status" `
+	Found                      bool             `db:"found" `
+	FixityRun                  bool             `db:"fixity_run" `
+	Moved                      bool             `db:"moved" `
+	Cleaned                    bool             `db:"cleaned" `
+	Replicated                 bool             `db:"replicated" `
+	ReIndexed                  bool             `db:"re_indexed" `
+	CurrentLocation            null.Val[string] `db:"current_location" `
+	Size                       null.Val[int64]  `db:"size" `
+	LocationUUID               null.Val[string] `db:"location_uuid" `
+	Priority                   int64            `db:"priority" `
+	SourceLocationUUID         string           `db:"source_location_uuid" `
+	MoveTargetLocationUUID     string           `db:"move_target_location_uuid" `
+	MoveTargetOverride         string           `db:"move_target_override" `
+	ReplicationTargetsOverride string           `db:"replication_targets_override" `
+	Tags                       string           `db:"tags" `
 
 	R aipR `db:"-" `
 }
@@ -53,6 +59,8 @@ type AipsQuery = *sqlite.ViewQuery[*Aip, AipSlice]
 // aipR is where relationships are stored.
 type aipR struct {
 	AipReplications AipReplicationSlice // fk_aip_replication_0
+	AipSteps        AipStepSlice        // fk_aip_steps_0
+	BatchAips       BatchAipSlice       // fk_batch_aips_0
 	Errors          ErrorSlice          // fk_errors_0
 	Events          EventSlice          // fk_events_0
 }
@@ -60,39 +68,51 @@ type aipR struct {
 func buildAipColumns(alias string) aipColumns {
 	return aipColumns{
 		ColumnsExpr: expr.NewColumnsExpr(
-			"id", "uuid", "status", "found", "fixity_run", "moved", "cleaned", "replicated", "re_indexed", "current_location", "size", "location_uuid",
+			"id", "uuid", "status", "found", "fixity_run", "moved", "cleaned", "replicated", "re_indexed", "current_location", "size", "location_uuid", "priority", "source_location_uuid", "move_target_location_uuid", "move_target_override", "replication_targets_override", "tags",
 		).WithParent("aips"),
-		tableAlias:      alias,
-		ID:              sqlite.Quote(alias, "id"),
-		UUID:            sqlite.Quote(alias, "uuid"),
-		Status:          sqlite.Quote(alias, "status"),
-		Found:           sqlite.Quote(alias, "found"),
-		FixityRun:       sqlite.Quote(alias, "fixity_run"),
-		Moved:           sqlite.Quote(alias, "moved"),
-		Cleaned:         sqlite.Quote(alias, "cleaned"),
-		Replicated:      sqlite.Quote(alias, "replicated"),
-		ReIndexed:       sqlite.Quote(alias, "re_indexed"),
-		CurrentLocation: sqlite.Quote(alias, "current_location"),
-		Size:            sqlite.Quote(alias, "size"),
-		LocationUUID:    sqlite.Quote(alias, "location_uuid"),
+		tableAlias:                 alias,
+		ID:                         sqlite.Quote(alias, "id"),
+		UUID:                       sqlite.Quote(alias, "uuid"),
+		Status:                     sqlite.Quote(alias, "status"),
+		Found:                      sqlite.Quote(alias, "found"),
+		FixityRun:                  sqlite.Quote(alias, "fixity_run"),
+		Moved:                      sqlite.Quote(alias, "moved"),
+		Cleaned:                    sqlite.Quote(alias, "cleaned"),
+		Replicated:                 sqlite.Quote(alias, "replicated"),
+		ReIndexed:                  sqlite.Quote(alias, "re_indexed"),
+		CurrentLocation:            sqlite.Quote(alias, "current_location"),
+		Size:                       sqlite.Quote(alias, "size"),
+		LocationUUID:               sqlite.Quote(alias, "location_uuid"),
+		Priority:                   sqlite.Quote(alias, "priority"),
+		SourceLocationUUID:         sqlite.Quote(alias, "source_location_uuid"),
+		MoveTargetLocationUUID:     sqlite.Quote(alias, "move_target_location_uuid"),
+		MoveTargetOverride:         sqlite.Quote(alias, "move_target_override"),
+		ReplicationTargetsOverride: sqlite.Quote(alias, "replication_targets_override"),
+		Tags:                       sqlite.Quote(alias, "tags"),
 	}
 }
 
 type aipColumns struct {
 	expr.ColumnsExpr
-	tableAlias      string
-	ID              sqlite.Expression
-	UUID            sqlite.Expression
-	Status          sqlite.Expression
-	Found           sqlite.Expression
-	FixityRun       sqlite.Expression
-	Moved           sqlite.Expression
-	Cleaned         sqlite.Expression
-	Replicated      sqlite.Expression
-	ReIndexed       sqlite.Expression
-	CurrentLocation sqlite.Expression
-	Size            sqlite.Expression
-	LocationUUID    sqlite.Expression
+	tableAlias                 string
+	ID                         sqlite.Expression
+	UUID                       sqlite.Expression
+	Status                     sqlite.Expression
+	Found                      sqlite.Expression
+	FixityRun                  sqlite.Expression
+	Moved                      sqlite.Expression
+	Cleaned                    sqlite.Expression
+	Replicated                 sqlite.Expression
+	ReIndexed                  sqlite.Expression
+	CurrentLocation            sqlite.Expression
+	Size                       sqlite.Expression
+	LocationUUID               sqlite.Expression
+	Priority                   sqlite.Expression
+	SourceLocationUUID         sqlite.Expression
+	MoveTargetLocationUUID     sqlite.Expression
+	MoveTargetOverride         sqlite.Expression
+	ReplicationTargetsOverride sqlite.Expression
+	Tags                       sqlite.Expression
 }
 
 func (c aipColumns) Alias() string {
@@ -107,22 +127,28 @@ func (aipColumns) AliasedAs(alias string) aipColumns {
 // All values are optional, and do not have to be set
 // Generated columns are not included
 type AipSetter struct {
-	ID              omit.Val[int64]      `db:"id,pk" `
-	UUID            omit.Val[string]     `db:"uuid" `
-	Status          omit.Val[string]     `db:"status" `
-	Found           omit.Val[bool]       `db:"found" `
-	FixityRun       omit.Val[bool]       `db:"fixity_run" `
-	Moved           omit.Val[bool]       `db:"moved" `
-	Cleaned         omit.Val[bool]       `db:"cleaned" `
-	Replicated      omit.Val[bool]       `db:"replicated" `
-	ReIndexed       omit.Val[bool]       `db:"re_indexed" `
-	CurrentLocation omitnull.Val[string] `db:"current_location" `
-	Size            omitnull.Val[int64]  `db:"size" `
-	LocationUUID    omitnull.Val[string] `db:"location_uuid" `
+	ID                         omit.Val[int64]      `db:"id,pk" `
+	UUID                       omit.Val[string]     `db:"uuid" `
+	Status                     omit.Val[string]     `db:"status" `
+	Found                      omit.Val[bool]       `db:"found" `
+	FixityRun                  omit.Val[bool]       `db:"fixity_run" `
+	Moved                      omit.Val[bool]       `db:"moved" `
+	Cleaned                    omit.Val[bool]       `db:"cleaned" `
+	Replicated                 omit.Val[bool]       `db:"replicated" `
+	ReIndexed                  omit.Val[bool]       `db:"re_indexed" `
+	CurrentLocation            omitnull.Val[string] `db:"current_location" `
+	Size                       omitnull.Val[int64]  `db:"size" `
+	LocationUUID               omitnull.Val[string] `db:"location_uuid" `
+	Priority                   omit.Val[int64]      `db:"priority" `
+	SourceLocationUUID         omit.Val[string]     `db:"source_location_uuid" `
+	MoveTargetLocationUUID     omit.Val[string]     `db:"move_target_location_uuid" `
+	MoveTargetOverride         omit.Val[string]     `db:"move_target_override" `
+	ReplicationTargetsOverride omit.Val[string]     `db:"replication_targets_override" `
+	Tags                       omit.Val[string]     `db:"tags" `
 }
 
 func (s AipSetter) SetColumns() []string {
-	vals := make([]string, 0, 12)
+	vals := make([]string, 0, 18)
 	if s.ID.IsValue() {
 		vals = append(vals, "id")
 	}
@@ -159,6 +185,24 @@ func (s AipSetter) SetColumns() []string {
 	if !s.LocationUUID.IsUnset() {
 		vals = append(vals, "location_uuid")
 	}
+	if s.Priority.IsValue() {
+		vals = append(vals, "priority")
+	}
+	if s.SourceLocationUUID.IsValue() {
+		vals = append(vals, "source_location_uuid")
+	}
+	if s.MoveTargetLocationUUID.IsValue() {
+		vals = append(vals, "move_target_location_uuid")
+	}
+	if s.MoveTargetOverride.IsValue() {
+		vals = append(vals, "move_target_override")
+	}
+	if s.ReplicationTargetsOverride.IsValue() {
+		vals = append(vals, "replication_targets_override")
+	}
+	if s.Tags.IsValue() {
+		vals = append(vals, "tags")
+	}
 	return vals
 }
 
@@ -199,6 +243,24 @@ func (s AipSetter) Overwrite(t *Aip) {
 	if !s.LocationUUID.IsUnset() {
 		t.LocationUUID = s.LocationUUID.MustGetNull()
 	}
+	if s.Priority.IsValue() {
+		t.Priority = s.Priority.MustGet()
+	}
+	if s.SourceLocationUUID.IsValue() {
+		t.SourceLocationUUID = s.SourceLocationUUID.MustGet()
+	}
+	if s.MoveTargetLocationUUID.IsValue() {
+		t.MoveTargetLocationUUID = s.MoveTargetLocationUUID.MustGet()
+	}
+	if s.MoveTargetOverride.IsValue() {
+		t.MoveTargetOverride = s.MoveTargetOverride.MustGet()
+	}
+	if s.ReplicationTargetsOverride.IsValue() {
+		t.ReplicationTargetsOverride = s.ReplicationTargetsOverride.MustGet()
+	}
+	if s.Tags.IsValue() {
+		t.Tags = s.Tags.MustGet()
+	}
 }
 
 func (s *AipSetter) Apply(q *dialect.InsertQuery) {
@@ -215,7 +277,7 @@ func (s *AipSetter) Apply(q *dialect.InsertQuery) {
 	}
 
 	q.AppendValues(bob.ExpressionFunc(func(ctx context.Context, w io.Writer, d bob.Dialect, start int) ([]any, error) {
-		vals := make([]bob.Expression, 0, 12)
+		vals := make([]bob.Expression, 0, 18)
 		if s.ID.IsValue() {
 			vals = append(vals, sqlite.Arg(s.ID.MustGet()))
 		}
@@ -264,6 +326,30 @@ func (s *AipSetter) Apply(q *dialect.InsertQuery) {
 			vals = append(vals, sqlite.Arg(s.LocationUUID.MustGetNull()))
 		}
 
+		if s.Priority.IsValue() {
+			vals = append(vals, sqlite.Arg(s.Priority.MustGet()))
+		}
+
+		if s.SourceLocationUUID.IsValue() {
+			vals = append(vals, sqlite.Arg(s.SourceLocationUUID.MustGet()))
+		}
+
+		if s.MoveTargetLocationUUID.IsValue() {
+			vals = append(vals, sqlite.Arg(s.MoveTargetLocationUUID.MustGet()))
+		}
+
+		if s.MoveTargetOverride.IsValue() {
+			vals = append(vals, sqlite.Arg(s.MoveTargetOverride.MustGet()))
+		}
+
+		if s.ReplicationTargetsOverride.IsValue() {
+			vals = append(vals, sqlite.Arg(s.ReplicationTargetsOverride.MustGet()))
+		}
+
+		if s.Tags.IsValue() {
+			vals = append(vals, sqlite.Arg(s.Tags.MustGet()))
+		}
+
 		if len(vals) == 0 {
 			vals = append(vals, sqlite.Arg(nil))
 		}
@@ -277,7 +363,7 @@ func (s AipSetter) UpdateMod() bob.Mod[*dialect.UpdateQuery] {
 }
 
 func (s AipSetter) Expressions(prefix ...string) []bob.Expression {
-	exprs := make([]bob.Expression, 0, 12)
+	exprs := make([]bob.Expression, 0, 18)
 
 	if s.ID.IsValue() {
 		exprs = append(exprs, expr.Join{Sep: " = ", Exprs: []bob.Expression{
@@ -363,6 +449,48 @@ func (s AipSetter) Expressions(prefix ...string) []bob.Expression {
 		}})
 	}
 
+	if s.Priority.IsValue() {
+		exprs = append(exprs, expr.Join{Sep: " = ", Exprs: []bob.Expression{
+			sqlite.Quote(append(prefix, "priority")...),
+			sqlite.Arg(s.Priority),
+		}})
+	}
+
+	if s.SourceLocationUUID.IsValue() {
+		exprs = append(exprs, expr.Join{Sep: " = ", Exprs: []bob.Expression{
+			sqlite.Quote(append(prefix, "source_location_uuid")...),
+			sqlite.Arg(s.SourceLocationUUID),
+		}})
+	}
+
+	if s.MoveTargetLocationUUID.IsValue() {
+		exprs = append(exprs, expr.Join{Sep: " = ", Exprs: []bob.Expression{
+			sqlite.Quote(append(prefix, "move_target_location_uuid")...),
+			sqlite.Arg(s.MoveTargetLocationUUID),
+		}})
+	}
+
+	if s.MoveTargetOverride.IsValue() {
+		exprs = append(exprs, expr.Join{Sep: " = ", Exprs: []bob.Expression{
+			sqlite.Quote(append(prefix, "move_target_override")...),
+			sqlite.Arg(s.MoveTargetOverride),
+		}})
+	}
+
+	if s.ReplicationTargetsOverride.IsValue() {
+		exprs = append(exprs, expr.Join{Sep: " = ", Exprs: []bob.Expression{
+			sqlite.Quote(append(prefix, "replication_targets_override")...),
+			sqlite.Arg(s.ReplicationTargetsOverride),
+		}})
+	}
+
+	if s.Tags.IsValue() {
+		exprs = append(exprs, expr.Join{Sep: " = ", Exprs: []bob.Expression{
+			sqlite.Quote(append(prefix, "tags")...),
+			sqlite.Arg(s.Tags),
+		}})
+	}
+
 	return exprs
 }
 
@@ -608,6 +736,44 @@ func (os AipSlice) AipReplications(mods ...bob.Mod[*dialect.SelectQuery]) AipRep
 	)...)
 }
 
+// AipSteps starts a query for related objects on aip_steps
+func (o *Aip) AipSteps(mods ...bob.Mod[*dialect.SelectQuery]) AipStepsQuery {
+	return AipSteps.Query(append(mods,
+		sm.Where(AipSteps.Columns.AipID.EQ(sqlite.Arg(o.ID))),
+	)...)
+}
+
+func (os AipSlice) AipSteps(mods ...bob.Mod[*dialect.SelectQuery]) AipStepsQuery {
+	PKArgSlice := make([]bob.Expression, len(os))
+	for i, o := range os {
+		PKArgSlice[i] = sqlite.ArgGroup(o.ID)
+	}
+	PKArgExpr := sqlite.Group(PKArgSlice...)
+
+	return AipSteps.Query(append(mods,
+		sm.Where(sqlite.Group(AipSteps.Columns.AipID).OP("IN", PKArgExpr)),
+	)...)
+}
+
+// BatchAips starts a query for related objects on batch_aips
+func (o *Aip) BatchAips(mods ...bob.Mod[*dialect.SelectQuery]) BatchAipsQuery {
+	return BatchAips.Query(append(mods,
+		sm.Where(BatchAips.Columns.AipID.EQ(sqlite.Arg(o.ID))),
+	)...)
+}
+
+func (os AipSlice) BatchAips(mods ...bob.Mod[*dialect.SelectQuery]) BatchAipsQuery {
+	PKArgSlice := make([]bob.Expression, len(os))
+	for i, o := range os {
+		PKArgSlice[i] = sqlite.ArgGroup(o.ID)
+	}
+	PKArgExpr := sqlite.Group(PKArgSlice...)
+
+	return BatchAips.Query(append(mods,
+		sm.Where(sqlite.Group(BatchAips.Columns.AipID).OP("IN", PKArgExpr)),
+	)...)
+}
+
 // Errors starts a query for related objects on errors
 func (o *Aip) Errors(mods ...bob.Mod[*dialect.SelectQuery]) ErrorsQuery {
 	return Errors.Query(append(mods,
@@ -714,6 +880,142 @@ func (aip0 *Aip) AttachAipReplications(ctx context.Context, exec bob.Executor, r
 	return nil
 }
 
+func insertAipAipSteps0(ctx context.Context, exec bob.Executor, aipSteps1 []*AipStepSetter, aip0 *Aip) (AipStepSlice, error) {
+	for i := range aipSteps1 {
+		aipSteps1[i].AipID = omit.From(aip0.ID)
+	}
+
+	ret, err := AipSteps.Insert(bob.ToMods(aipSteps1...)).All(ctx, exec)
+	if err != nil {
+		return ret, fmt.Errorf("insertAipAipSteps0: %w", err)
+	}
+
+	return ret, nil
+}
+
+func attachAipAipSteps0(ctx context.Context, exec bob.Executor, count int, aipSteps1 AipStepSlice, aip0 *Aip) (AipStepSlice, error) {
+	setter := &AipStepSetter{
+		AipID: omit.From(aip0.ID),
+	}
+
+	err := aipSteps1.UpdateAll(ctx, exec, *setter)
+	if err != nil {
+		return nil, fmt.Errorf("attachAipAipSteps0: %w", err)
+	}
+
+	return aipSteps1, nil
+}
+
+func (aip0 *Aip) InsertAipSteps(ctx context.Context, exec bob.Executor, related ...*AipStepSetter) error {
+	if len(related) == 0 {
+		return nil
+	}
+
+	var err error
+
+	aipSteps1, err := insertAipAipSteps0(ctx, exec, related, aip0)
+	if err != nil {
+		return err
+	}
+
+	aip0.R.AipSteps = append(aip0.R.AipSteps, aipSteps1...)
+
+	for _, rel := range aipSteps1 {
+		rel.R.Aip = aip0
+	}
+	return nil
+}
+
+func (aip0 *Aip) AttachAipSteps(ctx context.Context, exec bob.Executor, related ...*AipStep) error {
+	if len(related) == 0 {
+		return nil
+	}
+
+	var err error
+	aipSteps1 := AipStepSlice(related)
+
+	_, err = attachAipAipSteps0(ctx, exec, len(related), aipSteps1, aip0)
+	if err != nil {
+		return err
+	}
+
+	aip0.R.AipSteps = append(aip0.R.AipSteps, aipSteps1...)
+
+	for _, rel := range related {
+		rel.R.Aip = aip0
+	}
+
+	return nil
+}
+
+func insertAipBatchAips0(ctx context.Context, exec bob.Executor, batchAips1 []*BatchAipSetter, aip0 *Aip) (BatchAipSlice, error) {
+	for i := range batchAips1 {
+		batchAips1[i].AipID = omit.From(aip0.ID)
+	}
+
+	ret, err := BatchAips.Insert(bob.ToMods(batchAips1...)).All(ctx, exec)
+	if err != nil {
+		return ret, fmt.Errorf("insertAipBatchAips0: %w", err)
+	}
+
+	return ret, nil
+}
+
+func attachAipBatchAips0(ctx context.Context, exec bob.Executor, count int, batchAips1 BatchAipSlice, aip0 *Aip) (BatchAipSlice, error) {
+	setter := &BatchAipSetter{
+		AipID: omit.From(aip0.ID),
+	}
+
+	err := batchAips1.UpdateAll(ctx, exec, *setter)
+	if err != nil {
+		return nil, fmt.Errorf("attachAipBatchAips0: %w", err)
+	}
+
+	return batchAips1, nil
+}
+
+func (aip0 *Aip) InsertBatchAips(ctx context.Context, exec bob.Executor, related ...*BatchAipSetter) error {
+	if len(related) == 0 {
+		return nil
+	}
+
+	var err error
+
+	batchAips1, err := insertAipBatchAips0(ctx, exec, related, aip0)
+	if err != nil {
+		return err
+	}
+
+	aip0.R.BatchAips = append(aip0.R.BatchAips, batchAips1...)
+
+	for _, rel := range batchAips1 {
+		rel.R.Aip = aip0
+	}
+	return nil
+}
+
+func (aip0 *Aip) AttachBatchAips(ctx context.Context, exec bob.Executor, related ...*BatchAip) error {
+	if len(related) == 0 {
+		return nil
+	}
+
+	var err error
+	batchAips1 := BatchAipSlice(related)
+
+	_, err = attachAipBatchAips0(ctx, exec, len(related), batchAips1, aip0)
+	if err != nil {
+		return err
+	}
+
+	aip0.R.BatchAips = append(aip0.R.BatchAips, batchAips1...)
+
+	for _, rel := range related {
+		rel.R.Aip = aip0
+	}
+
+	return nil
+}
+
 func insertAipErrors0(ctx context.Context, exec bob.Executor, errors1 []*ErrorSetter, aip0 *Aip) (ErrorSlice, error) {
 	for i := range errors1 {
 		errors1[i].AipID = omit.From(aip0.ID)
@@ -851,18 +1153,24 @@ func (aip0 *Aip) AttachEvents(ctx context.Context, exec bob.Executor, related ..
 }
 
 type aipWhere[Q sqlite.Filterable] struct {
-	ID              sqlite.WhereMod[Q, int64]
-	UUID            sqlite.WhereMod[Q, string]
-	Status          sqlite.WhereMod[Q, string]
-	Found           sqlite.WhereMod[Q, bool]
-	FixityRun       sqlite.WhereMod[Q, bool]
-	Moved           sqlite.WhereMod[Q, bool]
-	Cleaned         sqlite.WhereMod[Q, bool]
-	Replicated      sqlite.WhereMod[Q, bool]
-	ReIndexed       sqlite.WhereMod[Q, bool]
-	CurrentLocation sqlite.WhereNullMod[Q, string]
-	Size            sqlite.WhereNullMod[Q, int64]
-	LocationUUID    sqlite.WhereNullMod[Q, string]
+	ID                         sqlite.WhereMod[Q, int64]
+	UUID                       sqlite.WhereMod[Q, string]
+	Status                     sqlite.WhereMod[Q, string]
+	Found                      sqlite.WhereMod[Q, bool]
+	FixityRun                  sqlite.WhereMod[Q, bool]
+	Moved                      sqlite.WhereMod[Q, bool]
+	Cleaned                    sqlite.WhereMod[Q, bool]
+	Replicated                 sqlite.WhereMod[Q, bool]
+	ReIndexed                  sqlite.WhereMod[Q, bool]
+	CurrentLocation            sqlite.WhereNullMod[Q, string]
+	Size                       sqlite.WhereNullMod[Q, int64]
+	LocationUUID               sqlite.WhereNullMod[Q, string]
+	Priority                   sqlite.WhereMod[Q, int64]
+	SourceLocationUUID         sqlite.WhereMod[Q, string]
+	MoveTargetLocationUUID     sqlite.WhereMod[Q, string]
+	MoveTargetOverride         sqlite.WhereMod[Q, string]
+	ReplicationTargetsOverride sqlite.WhereMod[Q, string]
+	Tags                       sqlite.WhereMod[Q, string]
 }
 
 func (aipWhere[Q]) AliasedAs(alias string) aipWhere[Q] {
@@ -871,18 +1179,24 @@ func (aipWhere[Q]) AliasedAs(alias string) aipWhere[Q] {
 
 func buildAipWhere[Q sqlite.Filterable](cols aipColumns) aipWhere[Q] {
 	return aipWhere[Q]{
-		ID:              sqlite.Where[Q, int64](cols.ID),
-		UUID:            sqlite.Where[Q, string](cols.UUID),
-		Status:          sqlite.Where[Q, string](cols.Status),
-		Found:           sqlite.Where[Q, bool](cols.Found),
-		FixityRun:       sqlite.Where[Q, bool](cols.FixityRun),
-		Moved:           sqlite.Where[Q, bool](cols.Moved),
-		Cleaned:         sqlite.Where[Q, bool](cols.Cleaned),
-		Replicated:      sqlite.Where[Q, bool](cols.Replicated),
-		ReIndexed:       sqlite.Where[Q, bool](cols.ReIndexed),
-		CurrentLocation: sqlite.WhereNull[Q, string](cols.CurrentLocation),
-		Size:            sqlite.WhereNull[Q, int64](cols.Size),
-		LocationUUID:    sqlite.WhereNull[Q, string](cols.LocationUUID),
+		ID:                         sqlite.Where[Q, int64](cols.ID),
+		UUID:                       sqlite.Where[Q, string](cols.UUID),
+		Status:                     sqlite.Where[Q, string](cols.Status),
+		Found:                      sqlite.Where[Q, bool](cols.Found),
+		FixityRun:                  sqlite.Where[Q, bool](cols.FixityRun),
+		Moved:                      sqlite.Where[Q, bool](cols.Moved),
+		Cleaned:                    sqlite.Where[Q, bool](cols.Cleaned),
+		Replicated:                 sqlite.Where[Q, bool](cols.Replicated),
+		ReIndexed:                  sqlite.Where[Q, bool](cols.ReIndexed),
+		CurrentLocation:            sqlite.WhereNull[Q, string](cols.CurrentLocation),
+		Size:                       sqlite.WhereNull[Q, int64](cols.Size),
+		LocationUUID:               sqlite.WhereNull[Q, string](cols.LocationUUID),
+		Priority:                   sqlite.Where[Q, int64](cols.Priority),
+		SourceLocationUUID:         sqlite.Where[Q, string](cols.SourceLocationUUID),
+		MoveTargetLocationUUID:     sqlite.Where[Q, string](cols.MoveTargetLocationUUID),
+		MoveTargetOverride:         sqlite.Where[Q, string](cols.MoveTargetOverride),
+		ReplicationTargetsOverride: sqlite.Where[Q, string](cols.ReplicationTargetsOverride),
+		Tags:                       sqlite.Where[Q, string](cols.Tags),
 	}
 }
 
@@ -900,6 +1214,34 @@ func (o *Aip) Preload(name string, retrieved any) error {
 
 		o.R.AipReplications = rels
 
+		for _, rel := range rels {
+			if rel != nil {
+				rel.R.Aip = o
+			}
+		}
+		return nil
+	case "AipSteps":
+		rels, ok := retrieved.(AipStepSlice)
+		if !ok {
+			return fmt.Errorf("aip cannot load %T as %q", retrieved, name)
+		}
+
+		o.R.AipSteps = rels
+
+		for _, rel := range rels {
+			if rel != nil {
+				rel.R.Aip = o
+			}
+		}
+		return nil
+	case "BatchAips":
+		rels, ok := retrieved.(BatchAipSlice)
+		if !ok {
+			return fmt.Errorf("aip cannot load %T as %q", retrieved, name)
+		}
+
+		o.R.BatchAips = rels
+
 		for _, rel := range rels {
 			if rel != nil {
 				rel.R.Aip = o
@@ -947,6 +1289,8 @@ func buildAipPreloader() aipPreloader {
 
 type aipThenLoader[Q orm.Loadable] struct {
 	AipReplications func(...bob.Mod[*dialect.SelectQuery]) orm.Loader[Q]
+	AipSteps        func(...bob.Mod[*dialect.SelectQuery]) orm.Loader[Q]
+	BatchAips       func(...bob.Mod[*dialect.SelectQuery]) orm.Loader[Q]
 	Errors          func(...bob.Mod[*dialect.SelectQuery]) orm.Loader[Q]
 	Events          func(...bob.Mod[*dialect.SelectQuery]) orm.Loader[Q]
 }
@@ -955,6 +1299,12 @@ func buildAipThenLoader[Q orm.Loadable]() aipThenLoader[Q] {
 	type AipReplicationsLoadInterface interface {
 		LoadAipReplications(context.Context, bob.Executor, ...bob.Mod[*dialect.SelectQuery]) error
 	}
+	type AipStepsLoadInterface interface {
+		LoadAipSteps(context.Context, bob.Executor, ...bob.Mod[*dialect.SelectQuery]) error
+	}
+	type BatchAipsLoadInterface interface {
+		LoadBatchAips(context.Context, bob.Executor, ...bob.Mod[*dialect.SelectQuery]) error
+	}
 	type ErrorsLoadInterface interface {
 		LoadErrors(context.Context, bob.Executor, ...bob.Mod[*dialect.SelectQuery]) error
 	}
@@ -969,6 +1319,18 @@ func buildAipThenLoader[Q orm.Loadable]() aipThenLoader[Q] {
 				return retrieved.LoadAipReplications(ctx, exec, mods...)
 			},
 		),
+		AipSteps: thenLoadBuilder[Q](
+			"AipSteps",
+			func(ctx context.Context, exec bob.Executor, retrieved AipStepsLoadInterface, mods ...bob.Mod[*dialect.SelectQuery]) error {
+				return retrieved.LoadAipSteps(ctx, exec, mods...)
+			},
+		),
+		BatchAips: thenLoadBuilder[Q](
+			"BatchAips",
+			func(ctx context.Context, exec bob.Executor, retrieved BatchAipsLoadInterface, mods ...bob.Mod[*dialect.SelectQuery]) error {
+				return retrieved.LoadBatchAips(ctx, exec, mods...)
+			},
+		),
 		Errors: thenLoadBuilder[Q](
 			"Errors",
 			func(ctx context.Context, exec bob.Executor, retrieved ErrorsLoadInterface, mods ...bob.Mod[*dialect.SelectQuery]) error {
@@ -1045,6 +1407,128 @@ func (os AipSlice) LoadAipReplications(ctx context.Context, exec bob.Executor, m
 	return nil
 }
 
+// LoadAipSteps loads the aip's AipSteps into the .R struct
+func (o *Aip) LoadAipSteps(ctx context.Context, exec bob.Executor, mods ...bob.Mod[*dialect.SelectQuery]) error {
+	if o == nil {
+		return nil
+	}
+
+	// Reset the relationship
+	o.R.AipSteps = nil
+
+	related, err := o.AipSteps(mods...).All(ctx, exec)
+	if err != nil {
+		return err
+	}
+
+	for _, rel := range related {
+		rel.R.Aip = o
+	}
+
+	o.R.AipSteps = related
+	return nil
+}
+
+// LoadAipSteps loads the aip's AipSteps into the .R struct
+func (os AipSlice) LoadAipSteps(ctx context.Context, exec bob.Executor, mods ...bob.Mod[*dialect.SelectQuery]) error {
+	if len(os) == 0 {
+		return nil
+	}
+
+	aipSteps, err := os.AipSteps(mods...).All(ctx, exec)
+	if err != nil {
+		return err
+	}
+
+	for _, o := range os {
+		if o == nil {
+			continue
+		}
+
+		o.R.AipSteps = nil
+	}
+
+	for _, o := range os {
+		if o == nil {
+			continue
+		}
+
+		for _, rel := range aipSteps {
+
+			if !(o.ID == rel.AipID) {
+				continue
+			}
+
+			rel.R.Aip = o
+
+			o.R.AipSteps = append(o.R.AipSteps, rel)
+		}
+	}
+
+	return nil
+}
+
+// LoadBatchAips loads the aip's BatchAips into the .R struct
+func (o *Aip) LoadBatchAips(ctx context.Context, exec bob.Executor, mods ...bob.Mod[*dialect.SelectQuery]) error {
+	if o == nil {
+		return nil
+	}
+
+	// Reset the relationship
+	o.R.BatchAips = nil
+
+	related, err := o.BatchAips(mods...).All(ctx, exec)
+	if err != nil {
+		return err
+	}
+
+	for _, rel := range related {
+		rel.R.Aip = o
+	}
+
+	o.R.BatchAips = related
+	return nil
+}
+
+// LoadBatchAips loads the aip's BatchAips into the .R struct
+func (os AipSlice) LoadBatchAips(ctx context.Context, exec bob.Executor, mods ...bob.Mod[*dialect.SelectQuery]) error {
+	if len(os) == 0 {
+		return nil
+	}
+
+	batchAips, err := os.BatchAips(mods...).All(ctx, exec)
+	if err != nil {
+		return err
+	}
+
+	for _, o := range os {
+		if o == nil {
+			continue
+		}
+
+		o.R.BatchAips = nil
+	}
+
+	for _, o := range os {
+		if o == nil {
+			continue
+		}
+
+		for _, rel := range batchAips {
+
+			if !(o.ID == rel.AipID) {
+				continue
+			}
+
+			rel.R.Aip = o
+
+			o.R.BatchAips = append(o.R.BatchAips, rel)
+		}
+	}
+
+	return nil
+}
+
 // LoadErrors loads the aip's Errors into the .R struct
 func (o *Aip) LoadErrors(ctx context.Context, exec bob.Executor, mods ...bob.Mod[*dialect.SelectQuery]) error {
 	if o == nil {
@@ -1170,6 +1654,8 @@ func (os AipSlice) LoadEvents(ctx context.Context, exec bob.Executor, mods ...bo
 type aipJoins[Q dialect.Joinable] struct {
 	typ             string
 	AipReplications modAs[Q, aipReplicationColumns]
+	AipSteps        modAs[Q, aipStepColumns]
+	BatchAips       modAs[Q, batchAipColumns]
 	Errors          modAs[Q, errorColumns]
 	Events          modAs[Q, eventColumns]
 }
@@ -1195,6 +1681,34 @@ func buildAipJoins[Q dialect.Joinable](cols aipColumns, typ string) aipJoins[Q]
 				return mods
 			},
 		},
+		AipSteps: modAs[Q, aipStepColumns]{
+			c: AipSteps.Columns,
+			f: func(to aipStepColumns) bob.Mod[Q] {
+				mods := make(mods.QueryMods[Q], 0, 1)
+
+				{
+					mods = append(mods, dialect.Join[Q](typ, AipSteps.Name().As(to.Alias())).On(
+						to.AipID.EQ(cols.ID),
+					))
+				}
+
+				return mods
+			},
+		},
+		BatchAips: modAs[Q, batchAipColumns]{
+			c: BatchAips.Columns,
+			f: func(to batchAipColumns) bob.Mod[Q] {
+				mods := make(mods.QueryMods[Q], 0, 1)
+
+				{
+					mods = append(mods, dialect.Join[Q](typ, BatchAips.Name().As(to.Alias())).On(
+						to.AipID.EQ(cols.ID),
+					))
+				}
+
+				return mods
+			},
+		},
 		Errors: modAs[Q, errorColumns]{
 			c: Errors.Columns,
 			f: func(to errorColumns) bob.Mod[Q] {
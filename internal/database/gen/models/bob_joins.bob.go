@@ -31,10 +31,18 @@ func (j joinSet[Q]) AliasedAs(alias string) joinSet[Q] {
 }
 
 type joins[Q dialect.Joinable] struct {
-	AipReplications joinSet[aipReplicationJoins[Q]]
-	Aips            joinSet[aipJoins[Q]]
-	Errors          joinSet[errorJoins[Q]]
-	Events          joinSet[eventJoins[Q]]
+	AipReplications   joinSet[aipReplicationJoins[Q]]
+	AipSteps          joinSet[aipStepJoins[Q]]
+	Aips              joinSet[aipJoins[Q]]
+	BatchAips         joinSet[batchAipJoins[Q]]
+	BatchWorkflowAips joinSet[batchWorkflowAipJoins[Q]]
+	Batches           joinSet[batchJoins[Q]]
+	Errors            joinSet[errorJoins[Q]]
+	Events            joinSet[eventJoins[Q]]
+	LocationLeases    joinSet[locationLeaseJoins[Q]]
+	LocationTransfers joinSet[locationTransferJoins[Q]]
+	PlanItems         joinSet[planItemJoins[Q]]
+	Plans             joinSet[planJoins[Q]]
 }
 
 func buildJoinSet[Q interface{ aliasedAs(string) Q }, C any, F func(C, string) Q](c C, f F) joinSet[Q] {
@@ -47,10 +55,18 @@ func buildJoinSet[Q interface{ aliasedAs(string) Q }, C any, F func(C, string) Q
 
 func getJoins[Q dialect.Joinable]() joins[Q] {
 	return joins[Q]{
-		AipReplications: buildJoinSet[aipReplicationJoins[Q]](AipReplications.Columns, buildAipReplicationJoins),
-		Aips:            buildJoinSet[aipJoins[Q]](Aips.Columns, buildAipJoins),
-		Errors:          buildJoinSet[errorJoins[Q]](Errors.Columns, buildErrorJoins),
-		Events:          buildJoinSet[eventJoins[Q]](Events.Columns, buildEventJoins),
+		AipReplications:   buildJoinSet[aipReplicationJoins[Q]](AipReplications.Columns, buildAipReplicationJoins),
+		AipSteps:          buildJoinSet[aipStepJoins[Q]](AipSteps.Columns, buildAipStepJoins),
+		Aips:              buildJoinSet[aipJoins[Q]](Aips.Columns, buildAipJoins),
+		BatchAips:         buildJoinSet[batchAipJoins[Q]](BatchAips.Columns, buildBatchAipJoins),
+		BatchWorkflowAips: buildJoinSet[batchWorkflowAipJoins[Q]](BatchWorkflowAips.Columns, buildBatchWorkflowAipJoins),
+		Batches:           buildJoinSet[batchJoins[Q]](Batches.Columns, buildBatchJoins),
+		Errors:            buildJoinSet[errorJoins[Q]](Errors.Columns, buildErrorJoins),
+		Events:            buildJoinSet[eventJoins[Q]](Events.Columns, buildEventJoins),
+		LocationLeases:    buildJoinSet[locationLeaseJoins[Q]](LocationLeases.Columns, buildLocationLeaseJoins),
+		LocationTransfers: buildJoinSet[locationTransferJoins[Q]](LocationTransfers.Columns, buildLocationTransferJoins),
+		PlanItems:         buildJoinSet[planItemJoins[Q]](PlanItems.Columns, buildPlanItemJoins),
+		Plans:             buildJoinSet[planJoins[Q]](Plans.Columns, buildPlanJoins),
 	}
 }
 
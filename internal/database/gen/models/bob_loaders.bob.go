@@ -17,18 +17,34 @@ import (
 var Preload = getPreloaders()
 
 type preloaders struct {
-	AipReplication aipReplicationPreloader
-	Aip            aipPreloader
-	Error          errorPreloader
-	Event          eventPreloader
+	AipReplication   aipReplicationPreloader
+	AipStep          aipStepPreloader
+	Aip              aipPreloader
+	BatchAip         batchAipPreloader
+	BatchWorkflowAip batchWorkflowAipPreloader
+	Batch            batchPreloader
+	Error            errorPreloader
+	Event            eventPreloader
+	LocationLease    locationLeasePreloader
+	LocationTransfer locationTransferPreloader
+	PlanItem         planItemPreloader
+	Plan             planPreloader
 }
 
 func getPreloaders() preloaders {
 	return preloaders{
-		AipReplication: buildAipReplicationPreloader(),
-		Aip:            buildAipPreloader(),
-		Error:          buildErrorPreloader(),
-		Event:          buildEventPreloader(),
+		AipReplication:   buildAipReplicationPreloader(),
+		AipStep:          buildAipStepPreloader(),
+		Aip:              buildAipPreloader(),
+		BatchAip:         buildBatchAipPreloader(),
+		BatchWorkflowAip: buildBatchWorkflowAipPreloader(),
+		Batch:            buildBatchPreloader(),
+		Error:            buildErrorPreloader(),
+		Event:            buildEventPreloader(),
+		LocationLease:    buildLocationLeasePreloader(),
+		LocationTransfer: buildLocationTransferPreloader(),
+		PlanItem:         buildPlanItemPreloader(),
+		Plan:             buildPlanPreloader(),
 	}
 }
 
@@ -39,18 +55,34 @@ var (
 )
 
 type thenLoaders[Q orm.Loadable] struct {
-	AipReplication aipReplicationThenLoader[Q]
-	Aip            aipThenLoader[Q]
-	Error          errorThenLoader[Q]
-	Event          eventThenLoader[Q]
+	AipReplication   aipReplicationThenLoader[Q]
+	AipStep          aipStepThenLoader[Q]
+	Aip              aipThenLoader[Q]
+	BatchAip         batchAipThenLoader[Q]
+	BatchWorkflowAip batchWorkflowAipThenLoader[Q]
+	Batch            batchThenLoader[Q]
+	Error            errorThenLoader[Q]
+	Event            eventThenLoader[Q]
+	LocationLease    locationLeaseThenLoader[Q]
+	LocationTransfer locationTransferThenLoader[Q]
+	PlanItem         planItemThenLoader[Q]
+	Plan             planThenLoader[Q]
 }
 
 func getThenLoaders[Q orm.Loadable]() thenLoaders[Q] {
 	return thenLoaders[Q]{
-		AipReplication: buildAipReplicationThenLoader[Q](),
-		Aip:            buildAipThenLoader[Q](),
-		Error:          buildErrorThenLoader[Q](),
-		Event:          buildEventThenLoader[Q](),
+		AipReplication:   buildAipReplicationThenLoader[Q](),
+		AipStep:          buildAipStepThenLoader[Q](),
+		Aip:              buildAipThenLoader[Q](),
+		BatchAip:         buildBatchAipThenLoader[Q](),
+		BatchWorkflowAip: buildBatchWorkflowAipThenLoader[Q](),
+		Batch:            buildBatchThenLoader[Q](),
+		Error:            buildErrorThenLoader[Q](),
+		Event:            buildEventThenLoader[Q](),
+		LocationLease:    buildLocationLeaseThenLoader[Q](),
+		LocationTransfer: buildLocationTransferThenLoader[Q](),
+		PlanItem:         buildPlanItemThenLoader[Q](),
+		Plan:             buildPlanThenLoader[Q](),
 	}
 }
 
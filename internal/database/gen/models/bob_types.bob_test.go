@@ -11,11 +11,35 @@ var testDB bob.Transactor[bob.Tx]
 // Make sure the type AipReplication runs hooks after queries
 var _ bob.HookableType = &AipReplication{}
 
+// Make sure the type AipStep runs hooks after queries
+var _ bob.HookableType = &AipStep{}
+
 // Make sure the type Aip runs hooks after queries
 var _ bob.HookableType = &Aip{}
 
+// Make sure the type BatchAip runs hooks after queries
+var _ bob.HookableType = &BatchAip{}
+
+// Make sure the type BatchWorkflowAip runs hooks after queries
+var _ bob.HookableType = &BatchWorkflowAip{}
+
+// Make sure the type Batch runs hooks after queries
+var _ bob.HookableType = &Batch{}
+
 // Make sure the type Error runs hooks after queries
 var _ bob.HookableType = &Error{}
 
 // Make sure the type Event runs hooks after queries
 var _ bob.HookableType = &Event{}
+
+// Make sure the type LocationLease runs hooks after queries
+var _ bob.HookableType = &LocationLease{}
+
+// Make sure the type LocationTransfer runs hooks after queries
+var _ bob.HookableType = &LocationTransfer{}
+
+// Make sure the type PlanItem runs hooks after queries
+var _ bob.HookableType = &PlanItem{}
+
+// Make sure the type Plan runs hooks after queries
+var _ bob.HookableType = &Plan{}
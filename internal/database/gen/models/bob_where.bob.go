@@ -17,20 +17,44 @@ var (
 )
 
 func Where[Q sqlite.Filterable]() struct {
-	AipReplications aipReplicationWhere[Q]
-	Aips            aipWhere[Q]
-	Errors          errorWhere[Q]
-	Events          eventWhere[Q]
+	AipReplications   aipReplicationWhere[Q]
+	AipSteps          aipStepWhere[Q]
+	Aips              aipWhere[Q]
+	BatchAips         batchAipWhere[Q]
+	BatchWorkflowAips batchWorkflowAipWhere[Q]
+	Batches           batchWhere[Q]
+	Errors            errorWhere[Q]
+	Events            eventWhere[Q]
+	LocationLeases    locationLeaseWhere[Q]
+	LocationTransfers locationTransferWhere[Q]
+	PlanItems         planItemWhere[Q]
+	Plans             planWhere[Q]
 } {
 	return struct {
-		AipReplications aipReplicationWhere[Q]
-		Aips            aipWhere[Q]
-		Errors          errorWhere[Q]
-		Events          eventWhere[Q]
+		AipReplications   aipReplicationWhere[Q]
+		AipSteps          aipStepWhere[Q]
+		Aips              aipWhere[Q]
+		BatchAips         batchAipWhere[Q]
+		BatchWorkflowAips batchWorkflowAipWhere[Q]
+		Batches           batchWhere[Q]
+		Errors            errorWhere[Q]
+		Events            eventWhere[Q]
+		LocationLeases    locationLeaseWhere[Q]
+		LocationTransfers locationTransferWhere[Q]
+		PlanItems         planItemWhere[Q]
+		Plans             planWhere[Q]
 	}{
-		AipReplications: buildAipReplicationWhere[Q](AipReplications.Columns),
-		Aips:            buildAipWhere[Q](Aips.Columns),
-		Errors:          buildErrorWhere[Q](Errors.Columns),
-		Events:          buildEventWhere[Q](Events.Columns),
+		AipReplications:   buildAipReplicationWhere[Q](AipReplications.Columns),
+		AipSteps:          buildAipStepWhere[Q](AipSteps.Columns),
+		Aips:              buildAipWhere[Q](Aips.Columns),
+		BatchAips:         buildBatchAipWhere[Q](BatchAips.Columns),
+		BatchWorkflowAips: buildBatchWorkflowAipWhere[Q](BatchWorkflowAips.Columns),
+		Batches:           buildBatchWhere[Q](Batches.Columns),
+		Errors:            buildErrorWhere[Q](Errors.Columns),
+		Events:            buildEventWhere[Q](Events.Columns),
+		LocationLeases:    buildLocationLeaseWhere[Q](LocationLeases.Columns),
+		LocationTransfers: buildLocationTransferWhere[Q](LocationTransfers.Columns),
+		PlanItems:         buildPlanItemWhere[Q](PlanItems.Columns),
+		Plans:             buildPlanWhere[Q](Plans.Columns),
 	}
 }
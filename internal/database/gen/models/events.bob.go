@@ -32,6 +32,8 @@ type Event struct {
 	TotalDuration            null.Val[string] `db:"total_duration" `
 	TotalDurationNanoseconds null.Val[int64]  `db:"total_duration_nanoseconds" `
 	Details                  null.Val[string] `db:"details" `
+	Success                  bool             `db:"success" `
+	EndedAt                  null.Val[int64]  `db:"ended_at" `
 
 	R eventR `db:"-" `
 }
@@ -54,7 +56,7 @@ type eventR struct {
 func buildEventColumns(alias string) eventColumns {
 	return eventColumns{
 		ColumnsExpr: expr.NewColumnsExpr(
-			"id", "aip_id", "action", "time_started", "time_ended", "total_duration", "total_duration_nanoseconds", "details",
+			"id", "aip_id", "action", "time_started", "time_ended", "total_duration", "total_duration_nanoseconds", "details", "success", "ended_at",
 		).WithParent("events"),
 		tableAlias:               alias,
 		ID:                       sqlite.Quote(alias, "id"),
@@ -65,6 +67,8 @@ func buildEventColumns(alias string) eventColumns {
 		TotalDuration:            sqlite.Quote(alias, "total_duration"),
 		TotalDurationNanoseconds: sqlite.Quote(alias, "total_duration_nanoseconds"),
 		Details:                  sqlite.Quote(alias, "details"),
+		Success:                  sqlite.Quote(alias, "success"),
+		EndedAt:                  sqlite.Quote(alias, "ended_at"),
 	}
 }
 
@@ -79,6 +83,8 @@ type eventColumns struct {
 	TotalDuration            sqlite.Expression
 	TotalDurationNanoseconds sqlite.Expression
 	Details                  sqlite.Expression
+	Success                  sqlite.Expression
+	EndedAt                  sqlite.Expression
 }
 
 func (c eventColumns) Alias() string {
@@ -101,10 +107,12 @@ type EventSetter struct {
 	TotalDuration            omitnull.Val[string] `db:"total_duration" `
 	TotalDurationNanoseconds omitnull.Val[int64]  `db:"total_duration_nanoseconds" `
 	Details                  omitnull.Val[string] `db:"details" `
+	Success                  omit.Val[bool]       `db:"success" `
+	EndedAt                  omitnull.Val[int64]  `db:"ended_at" `
 }
 
 func (s EventSetter) SetColumns() []string {
-	vals := make([]string, 0, 8)
+	vals := make([]string, 0, 10)
 	if s.ID.IsValue() {
 		vals = append(vals, "id")
 	}
@@ -129,6 +137,12 @@ func (s EventSetter) SetColumns() []string {
 	if !s.Details.IsUnset() {
 		vals = append(vals, "details")
 	}
+	if s.Success.IsValue() {
+		vals = append(vals, "success")
+	}
+	if !s.EndedAt.IsUnset() {
+		vals = append(vals, "ended_at")
+	}
 	return vals
 }
 
@@ -157,6 +171,12 @@ func (s EventSetter) Overwrite(t *Event) {
 	if !s.Details.IsUnset() {
 		t.Details = s.Details.MustGetNull()
 	}
+	if s.Success.IsValue() {
+		t.Success = s.Success.MustGet()
+	}
+	if !s.EndedAt.IsUnset() {
+		t.EndedAt = s.EndedAt.MustGetNull()
+	}
 }
 
 func (s *EventSetter) Apply(q *dialect.InsertQuery) {
@@ -173,7 +193,7 @@ func (s *EventSetter) Apply(q *dialect.InsertQuery) {
 	}
 
 	q.AppendValues(bob.ExpressionFunc(func(ctx context.Context, w io.Writer, d bob.Dialect, start int) ([]any, error) {
-		vals := make([]bob.Expression, 0, 8)
+		vals := make([]bob.Expression, 0, 10)
 		if s.ID.IsValue() {
 			vals = append(vals, sqlite.Arg(s.ID.MustGet()))
 		}
@@ -206,6 +226,14 @@ func (s *EventSetter) Apply(q *dialect.InsertQuery) {
 			vals = append(vals, sqlite.Arg(s.Details.MustGetNull()))
 		}
 
+		if s.Success.IsValue() {
+			vals = append(vals, sqlite.Arg(s.Success.MustGet()))
+		}
+
+		if !s.EndedAt.IsUnset() {
+			vals = append(vals, sqlite.Arg(s.EndedAt.MustGetNull()))
+		}
+
 		if len(vals) == 0 {
 			vals = append(vals, sqlite.Arg(nil))
 		}
@@ -219,7 +247,7 @@ func (s EventSetter) UpdateMod() bob.Mod[*dialect.UpdateQuery] {
 }
 
 func (s EventSetter) Expressions(prefix ...string) []bob.Expression {
-	exprs := make([]bob.Expression, 0, 8)
+	exprs := make([]bob.Expression, 0, 10)
 
 	if s.ID.IsValue() {
 		exprs = append(exprs, expr.Join{Sep: " = ", Exprs: []bob.Expression{
@@ -277,6 +305,20 @@ func (s EventSetter) Expressions(prefix ...string) []bob.Expression {
 		}})
 	}
 
+	if s.Success.IsValue() {
+		exprs = append(exprs, expr.Join{Sep: " = ", Exprs: []bob.Expression{
+			sqlite.Quote(append(prefix, "success")...),
+			sqlite.Arg(s.Success),
+		}})
+	}
+
+	if !s.EndedAt.IsUnset() {
+		exprs = append(exprs, expr.Join{Sep: " = ", Exprs: []bob.Expression{
+			sqlite.Quote(append(prefix, "ended_at")...),
+			sqlite.Arg(s.EndedAt),
+		}})
+	}
+
 	return exprs
 }
 
@@ -579,6 +621,8 @@ type eventWhere[Q sqlite.Filterable] struct {
 	TotalDuration            sqlite.WhereNullMod[Q, string]
 	TotalDurationNanoseconds sqlite.WhereNullMod[Q, int64]
 	Details                  sqlite.WhereNullMod[Q, string]
+	Success                  sqlite.WhereMod[Q, bool]
+	EndedAt                  sqlite.WhereNullMod[Q, int64]
 }
 
 func (eventWhere[Q]) AliasedAs(alias string) eventWhere[Q] {
@@ -595,6 +639,8 @@ func buildEventWhere[Q sqlite.Filterable](cols eventColumns) eventWhere[Q] {
 		TotalDuration:            sqlite.WhereNull[Q, string](cols.TotalDuration),
 		TotalDurationNanoseconds: sqlite.WhereNull[Q, int64](cols.TotalDurationNanoseconds),
 		Details:                  sqlite.WhereNull[Q, string](cols.Details),
+		Success:                  sqlite.Where[Q, bool](cols.Success),
+		EndedAt:                  sqlite.WhereNull[Q, int64](cols.EndedAt),
 	}
 }
 
@@ -0,0 +1,150 @@
+-- schema
+CREATE TABLE IF NOT EXISTS aips (
+    id                          INTEGER PRIMARY KEY,
+    uuid                        TEXT NOT NULL UNIQUE CHECK (LENGTH(uuid) == 36),
+    status                      TEXT NOT NULL DEFAULT 'new',
+    found                       BOOLEAN NOT NULL DEFAULT FALSE,
+    fixity_run                  BOOLEAN NOT NULL DEFAULT FALSE,
+    moved                       BOOLEAN NOT NULL DEFAULT FALSE,
+    cleaned                     BOOLEAN NOT NULL DEFAULT FALSE,
+    replicated                  BOOLEAN NOT NULL DEFAULT FALSE,
+    re_indexed                  BOOLEAN NOT NULL DEFAULT FALSE,
+    current_location            TEXT DEFAULT '',
+    "size"                      UNSIGNED BIG INT,
+    location_uuid               TEXT,
+    priority                    INTEGER NOT NULL DEFAULT 0,
+    -- Source and move target of the location mapping chosen for the AIP,
+    -- empty without location mappings.
+    source_location_uuid        TEXT NOT NULL DEFAULT '',
+    move_target_location_uuid   TEXT NOT NULL DEFAULT '',
+    -- Overrides read from the input file. The lists are JSON arrays, empty
+    -- when not overridden.
+    move_target_override            TEXT NOT NULL DEFAULT '',
+    replication_targets_override    TEXT NOT NULL DEFAULT '',
+    tags                            TEXT NOT NULL DEFAULT ''
+);
+
+CREATE INDEX IF NOT EXISTS aips_uuid_idx ON aips ("uuid");
+
+CREATE TABLE IF NOT EXISTS errors (
+    id          INTEGER PRIMARY KEY,
+    aip_id      INTEGER NOT NULL,
+    msg         TEXT NOT NULL,
+    details     TEXT,
+
+    FOREIGN KEY (aip_id) REFERENCES aips (id) ON DELETE CASCADE
+);
+
+CREATE TABLE IF NOT EXISTS events (
+    id              INTEGER PRIMARY KEY,
+    aip_id          INTEGER NOT NULL,
+
+    action                      TEXT NOT NULL,
+    time_started                TEXT NOT NULL,
+    time_ended                  TEXT NOT NULL,
+    total_duration              TEXT,
+    total_duration_nanoseconds  BIGINT,
+    details                     TEXT,
+
+    FOREIGN KEY (aip_id) REFERENCES aips (id) ON DELETE CASCADE
+);
+
+CREATE TABLE IF NOT EXISTS aip_replication (
+    id              INTEGER PRIMARY KEY,
+    aip_id          INTEGER NOT NULL,
+
+    location_uuid   TEXT DEFAULT '',
+    replica_uuid    TEXT DEFAULT '',
+    status          TEXT NOT NULL DEFAULT 'new',
+    attempt         INTEGER NOT NULL DEFAULT 0,
+
+    fixity_status       TEXT,
+    fixity_checked_at   TEXT,
+
+    -- Name of the replication rule that selected the target.
+    rule                TEXT NOT NULL DEFAULT '',
+
+    FOREIGN KEY (aip_id) REFERENCES aips (id) ON DELETE CASCADE
+);
+
+CREATE TABLE IF NOT EXISTS aip_steps (
+    id              INTEGER PRIMARY KEY,
+    aip_id          INTEGER NOT NULL,
+
+    step            TEXT NOT NULL,
+    status          TEXT NOT NULL,
+    details         TEXT,
+    started_at      TEXT NOT NULL,
+    completed_at    TEXT,
+
+    UNIQUE (aip_id, step),
+    FOREIGN KEY (aip_id) REFERENCES aips (id) ON DELETE CASCADE
+);
+
+CREATE TABLE IF NOT EXISTS location_leases (
+    id              INTEGER PRIMARY KEY,
+    location_uuid   TEXT NOT NULL,
+    holder          TEXT NOT NULL,
+    acquired_at     TEXT NOT NULL,
+    "size"          INTEGER NOT NULL DEFAULT 0,
+
+    UNIQUE (location_uuid, holder)
+);
+
+CREATE TABLE IF NOT EXISTS location_transfers (
+    id              INTEGER PRIMARY KEY,
+    location_uuid   TEXT NOT NULL,
+    aip_uuid        TEXT NOT NULL,
+    "size"          INTEGER NOT NULL DEFAULT 0,
+    "day"           TEXT NOT NULL,
+    transferred_at  TEXT NOT NULL
+);
+
+CREATE INDEX IF NOT EXISTS location_transfers_day_idx ON location_transfers (location_uuid, "day");
+
+CREATE TABLE IF NOT EXISTS plans (
+    id              INTEGER PRIMARY KEY,
+    operation       TEXT NOT NULL,
+    created_at      TEXT NOT NULL,
+    total_aips      INTEGER NOT NULL DEFAULT 0,
+    total_bytes     INTEGER NOT NULL DEFAULT 0
+);
+
+CREATE TABLE IF NOT EXISTS plan_items (
+    id                      INTEGER PRIMARY KEY,
+    plan_id                 INTEGER NOT NULL,
+
+    aip_uuid                TEXT NOT NULL,
+    classification          TEXT NOT NULL,
+    location_uuid           TEXT NOT NULL DEFAULT '',
+    target_location_uuid    TEXT NOT NULL DEFAULT '',
+    "size"                  INTEGER NOT NULL DEFAULT 0,
+
+    FOREIGN KEY (plan_id) REFERENCES plans (id) ON DELETE CASCADE
+);
+
+CREATE INDEX IF NOT EXISTS plan_items_plan_id_idx ON plan_items (plan_id);
+
+-- Named batches of AIPs. The operation is set by the first move or replicate
+-- run of the batch, and config is a JSON snapshot of the configuration it
+-- used, without secrets.
+CREATE TABLE IF NOT EXISTS batches (
+    id              INTEGER PRIMARY KEY,
+    name            TEXT NOT NULL UNIQUE,
+    operation       TEXT NOT NULL DEFAULT '',
+    created_at      TEXT NOT NULL,
+    input_checksum  TEXT NOT NULL DEFAULT '',
+    config          TEXT NOT NULL DEFAULT ''
+);
+
+CREATE TABLE IF NOT EXISTS batch_aips (
+    id          INTEGER PRIMARY KEY,
+    batch_id    INTEGER NOT NULL,
+    aip_id      INTEGER NOT NULL,
+
+    UNIQUE (batch_id, aip_id),
+    FOREIGN KEY (batch_id) REFERENCES batches (id) ON DELETE CASCADE,
+    FOREIGN KEY (aip_id) REFERENCES aips (id) ON DELETE CASCADE
+);
+
+CREATE INDEX IF NOT EXISTS batch_aips_aip_id_idx ON batch_aips (aip_id);
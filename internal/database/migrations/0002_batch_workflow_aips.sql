@@ -0,0 +1,11 @@
+-- The AIPs submitted to a batch workflow, in the order the workflow starts
+-- them. The workflow only carries its ID and loads the AIPs a page at a time,
+-- which keeps its input and history small for large batches.
+CREATE TABLE batch_workflow_aips (
+    id          INTEGER PRIMARY KEY,
+    batch_id    TEXT NOT NULL,
+    position    INTEGER NOT NULL,
+    aip_uuid    TEXT NOT NULL,
+
+    UNIQUE (batch_id, position)
+);
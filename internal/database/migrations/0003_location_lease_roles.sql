@@ -0,0 +1,4 @@
+-- Whether a lease is held to read from the location ("source") or to write to
+-- it ("target"), so each can have its own concurrency limit. Leases taken
+-- before roles were recorded count as targets.
+ALTER TABLE location_leases ADD COLUMN role TEXT NOT NULL DEFAULT '';
@@ -0,0 +1,18 @@
+-- Whether the operation recorded by an event succeeded, and when it ended in
+-- seconds since the Unix epoch, so the status can be summarized in SQL.
+ALTER TABLE events ADD COLUMN success BOOLEAN NOT NULL DEFAULT TRUE;
+ALTER TABLE events ADD COLUMN ended_at INTEGER;
+
+-- The outcome of older events was not recorded: those of failed AIPs are
+-- taken as failed.
+UPDATE events SET success = FALSE WHERE aip_id IN (SELECT id FROM aips WHERE status = 'failed');
+
+-- time_ended is written by time.Time.String, e.g.
+-- "2025-01-01 12:00:00.123456789 +0000 UTC m=+0.012345678".
+UPDATE events SET ended_at = CAST(strftime('%s',
+    substr(time_ended, 1, 19) ||
+    substr(substr(time_ended, 20), instr(substr(time_ended, 20), ' ') + 1, 3) || ':' ||
+    substr(substr(time_ended, 20), instr(substr(time_ended, 20), ' ') + 4, 2)
+) AS INTEGER);
+
+CREATE INDEX IF NOT EXISTS events_ended_at_idx ON events (ended_at);
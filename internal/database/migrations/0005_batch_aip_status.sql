@@ -0,0 +1,5 @@
+-- The status an AIP ended a batch with, recorded when the AIP joins a later
+-- batch that processes it again, so the reports of the earlier batch keep its
+-- outcome. It is empty while the batch is the latest of the AIP, whose status
+-- is then that of the aips table.
+ALTER TABLE batch_aips ADD COLUMN status TEXT NOT NULL DEFAULT '';
@@ -2,5 +2,8 @@ package migrations
 
 import "embed"
 
-//go:embed schema.sql
+// FS holds the migrations, named NNNN_description.sql and applied in order
+// of their version number.
+//
+//go:embed *.sql
 var FS embed.FS
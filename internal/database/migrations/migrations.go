@@ -0,0 +1,271 @@
+package migrations
+
+import (
+	"context"
+	"fmt"
+	"io/fs"
+	"path"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/stephenafamo/bob"
+)
+
+// Migration is a versioned change of the database schema.
+type Migration struct {
+	Version int
+	Name    string
+	SQL     string
+}
+
+// AppliedMigration is a migration recorded in the schema_version table.
+type AppliedMigration struct {
+	Version   int
+	Name      string
+	AppliedAt time.Time
+}
+
+// Status describes the schema of a database.
+type Status struct {
+	// Version is the version of the last migration applied, zero when none
+	// was.
+	Version int
+	Applied []AppliedMigration
+	Pending []Migration
+
+	// Empty reports whether the database has no tables yet.
+	Empty bool
+	// Legacy reports whether the database was created before versioned
+	// migrations, by applying schema.sql on every start.
+	Legacy bool
+}
+
+// Latest returns the version of the last migration.
+func (s *Status) Latest() int {
+	if len(s.Pending) > 0 {
+		return s.Pending[len(s.Pending)-1].Version
+	}
+	return s.Version
+}
+
+const createSchemaVersion = `CREATE TABLE IF NOT EXISTS schema_version (
+    version     INTEGER PRIMARY KEY,
+    name        TEXT NOT NULL,
+    applied_at  TEXT NOT NULL
+)`
+
+// All returns the migrations in FS, in order.
+func All() ([]Migration, error) {
+	return load(FS)
+}
+
+func load(fsys fs.FS) ([]Migration, error) {
+	names, err := fs.Glob(fsys, "*.sql")
+	if err != nil {
+		return nil, err
+	}
+
+	var migrations []Migration
+	for _, name := range names {
+		base := strings.TrimSuffix(path.Base(name), ".sql")
+		prefix, desc, ok := strings.Cut(base, "_")
+		version, err := strconv.Atoi(prefix)
+		if !ok || err != nil || version <= 0 {
+			return nil, fmt.Errorf("migration %s: name must be NNNN_description.sql", name)
+		}
+		data, err := fs.ReadFile(fsys, name)
+		if err != nil {
+			return nil, fmt.Errorf("read migration %s: %w", name, err)
+		}
+		migrations = append(migrations, Migration{Version: version, Name: desc, SQL: string(data)})
+	}
+
+	slices.SortFunc(migrations, func(a, b Migration) int { return a.Version - b.Version })
+	for i := 1; i < len(migrations); i++ {
+		if migrations[i].Version == migrations[i-1].Version {
+			return nil, fmt.Errorf("duplicate migration version %d", migrations[i].Version)
+		}
+	}
+	return migrations, nil
+}
+
+// GetStatus reads the schema version of the database and lists the pending
+// migrations.
+func GetStatus(ctx context.Context, db bob.DB) (*Status, error) {
+	migrations, err := All()
+	if err != nil {
+		return nil, err
+	}
+	return getStatus(ctx, db, migrations)
+}
+
+func getStatus(ctx context.Context, db bob.DB, migrations []Migration) (*Status, error) {
+	s := &Status{}
+
+	versioned, err := tableExists(ctx, db, "schema_version")
+	if err != nil {
+		return nil, err
+	}
+	if versioned {
+		if s.Applied, err = applied(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	if len(s.Applied) == 0 {
+		var tables int
+		row := db.QueryRowContext(ctx, "SELECT COUNT(*) FROM sqlite_schema WHERE type = 'table' AND name NOT LIKE 'sqlite_%' AND name != 'schema_version'")
+		if err := row.Scan(&tables); err != nil {
+			return nil, fmt.Errorf("list tables: %w", err)
+		}
+		s.Empty = tables == 0
+		s.Legacy = tables > 0
+	}
+
+	done := make(map[int]bool, len(s.Applied))
+	for _, m := range s.Applied {
+		done[m.Version] = true
+		s.Version = max(s.Version, m.Version)
+	}
+	for _, m := range migrations {
+		if !done[m.Version] {
+			s.Pending = append(s.Pending, m)
+		}
+	}
+	if len(s.Pending) > 0 && s.Pending[0].Version < s.Version {
+		return nil, fmt.Errorf("migration %d was not applied but the database is at version %d", s.Pending[0].Version, s.Version)
+	}
+	return s, nil
+}
+
+func applied(ctx context.Context, db bob.DB) ([]AppliedMigration, error) {
+	rows, err := db.QueryContext(ctx, "SELECT version, name, applied_at FROM schema_version ORDER BY version")
+	if err != nil {
+		return nil, fmt.Errorf("read schema_version: %w", err)
+	}
+	defer rows.Close() //nolint:errcheck
+
+	var migrations []AppliedMigration
+	for rows.Next() {
+		var (
+			m         AppliedMigration
+			appliedAt string
+		)
+		if err := rows.Scan(&m.Version, &m.Name, &appliedAt); err != nil {
+			return nil, fmt.Errorf("read schema_version: %w", err)
+		}
+		if m.AppliedAt, err = time.Parse(time.RFC3339, appliedAt); err != nil {
+			return nil, fmt.Errorf("parse applied_at of migration %d: %w", m.Version, err)
+		}
+		migrations = append(migrations, m)
+	}
+	return migrations, rows.Err()
+}
+
+// Migrate applies the pending migrations, each in its own transaction, and
+// returns them. The columns added to legacy databases before migrations were
+// versioned are added first, so the first migration finds the schema it
+// expects.
+func Migrate(ctx context.Context, db bob.DB) ([]Migration, error) {
+	migrations, err := All()
+	if err != nil {
+		return nil, err
+	}
+	return migrate(ctx, db, migrations)
+}
+
+func migrate(ctx context.Context, db bob.DB, migrations []Migration) ([]Migration, error) {
+	s, err := getStatus(ctx, db, migrations)
+	if err != nil {
+		return nil, err
+	}
+	if len(s.Pending) == 0 {
+		return nil, nil
+	}
+
+	if _, err := db.ExecContext(ctx, createSchemaVersion); err != nil {
+		return nil, fmt.Errorf("create schema_version: %w", err)
+	}
+	if s.Legacy {
+		if err := addLegacyColumns(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+
+	for _, m := range s.Pending {
+		err := db.RunInTx(ctx, nil, func(ctx context.Context, exec bob.Executor) error {
+			if _, err := exec.ExecContext(ctx, m.SQL); err != nil {
+				return err
+			}
+			_, err := exec.ExecContext(ctx,
+				"INSERT INTO schema_version (version, name, applied_at) VALUES (?, ?, ?)",
+				m.Version, m.Name, time.Now().UTC().Format(time.RFC3339),
+			)
+			return err
+		})
+		if err != nil {
+			return nil, fmt.Errorf("apply migration %d (%s): %w", m.Version, m.Name, err)
+		}
+	}
+	return s.Pending, nil
+}
+
+// Backup writes a copy of the database to path, which must not exist.
+func Backup(ctx context.Context, db bob.DB, path string) error {
+	if _, err := db.ExecContext(ctx, "VACUUM INTO ?", path); err != nil {
+		return fmt.Errorf("back up database to %s: %w", path, err)
+	}
+	return nil
+}
+
+func tableExists(ctx context.Context, db bob.DB, name string) (bool, error) {
+	var exists bool
+	row := db.QueryRowContext(ctx, "SELECT COUNT(*) > 0 FROM sqlite_schema WHERE type = 'table' AND name = ?", name)
+	if err := row.Scan(&exists); err != nil {
+		return false, fmt.Errorf("inspect table %s: %w", name, err)
+	}
+	return exists, nil
+}
+
+// legacyColumns lists the columns added to tables of legacy databases, which
+// only created missing tables, after the tables were first created.
+var legacyColumns = []struct {
+	table, column, definition string
+}{
+	{"aips", "priority", "INTEGER NOT NULL DEFAULT 0"},
+	{"location_leases", "size", "INTEGER NOT NULL DEFAULT 0"},
+	{"aip_replication", "fixity_status", "TEXT"},
+	{"aip_replication", "fixity_checked_at", "TEXT"},
+	{"aip_replication", "rule", "TEXT NOT NULL DEFAULT ''"},
+	{"aips", "source_location_uuid", "TEXT NOT NULL DEFAULT ''"},
+	{"aips", "move_target_location_uuid", "TEXT NOT NULL DEFAULT ''"},
+	{"aips", "move_target_override", "TEXT NOT NULL DEFAULT ''"},
+	{"aips", "replication_targets_override", "TEXT NOT NULL DEFAULT ''"},
+	{"aips", "tags", "TEXT NOT NULL DEFAULT ''"},
+}
+
+func addLegacyColumns(ctx context.Context, db bob.DB) error {
+	for _, c := range legacyColumns {
+		exists, err := tableExists(ctx, db, c.table)
+		if err != nil {
+			return err
+		}
+		if !exists {
+			// The first migration creates it.
+			continue
+		}
+		row := db.QueryRowContext(ctx, "SELECT COUNT(*) > 0 FROM pragma_table_info(?) WHERE name = ?", c.table, c.column)
+		if err := row.Scan(&exists); err != nil {
+			return fmt.Errorf("inspect table %s: %w", c.table, err)
+		}
+		if exists {
+			continue
+		}
+		stmt := fmt.Sprintf("ALTER TABLE %q ADD COLUMN %q %s", c.table, c.column, c.definition)
+		if _, err := db.ExecContext(ctx, stmt); err != nil {
+			return fmt.Errorf("add column %s.%s: %w", c.table, c.column, err)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,226 @@
+package migrations
+
+import (
+	"context"
+	"path/filepath"
+	"testing"
+	"testing/fstest"
+
+	"github.com/stephenafamo/bob"
+	"gotest.tools/v3/assert"
+	_ "modernc.org/sqlite"
+)
+
+func openDB(t *testing.T) bob.DB {
+	t.Helper()
+
+	db, err := bob.Open("sqlite", filepath.Join(t.TempDir(), "migrate.db"))
+	assert.NilError(t, err)
+	t.Cleanup(func() { _ = db.Close() })
+	return db
+}
+
+func TestLoad(t *testing.T) {
+	t.Parallel()
+
+	t.Run("Sorts by version", func(t *testing.T) {
+		t.Parallel()
+
+		migrations, err := load(fstest.MapFS{
+			"0010_tenth.sql":  {Data: []byte("SELECT 10;")},
+			"0002_second.sql": {Data: []byte("SELECT 2;")},
+			"README.md":       {Data: []byte("Not a migration.")},
+		})
+		assert.NilError(t, err)
+		assert.DeepEqual(t, migrations, []Migration{
+			{Version: 2, Name: "second", SQL: "SELECT 2;"},
+			{Version: 10, Name: "tenth", SQL: "SELECT 10;"},
+		})
+	})
+
+	t.Run("Rejects invalid names", func(t *testing.T) {
+		t.Parallel()
+
+		_, err := load(fstest.MapFS{"initial.sql": {}})
+		assert.Error(t, err, "migration initial.sql: name must be NNNN_description.sql")
+	})
+
+	t.Run("Rejects duplicate versions", func(t *testing.T) {
+		t.Parallel()
+
+		_, err := load(fstest.MapFS{"0001_a.sql": {}, "1_b.sql": {}})
+		assert.Error(t, err, "duplicate migration version 1")
+	})
+
+	t.Run("Embedded migrations", func(t *testing.T) {
+		t.Parallel()
+
+		migrations, err := All()
+		assert.NilError(t, err)
+		assert.Assert(t, len(migrations) > 0)
+		assert.Equal(t, migrations[0].Version, 1)
+	})
+}
+
+func TestMigrate(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	migrations := []Migration{
+		{Version: 1, Name: "initial", SQL: "CREATE TABLE aips (id INTEGER PRIMARY KEY);"},
+		{Version: 2, Name: "tags", SQL: "ALTER TABLE aips ADD COLUMN tags TEXT NOT NULL DEFAULT '';"},
+	}
+
+	t.Run("Empty database", func(t *testing.T) {
+		t.Parallel()
+
+		db := openDB(t)
+		s, err := getStatus(ctx, db, migrations)
+		assert.NilError(t, err)
+		assert.Assert(t, s.Empty)
+		assert.Equal(t, s.Version, 0)
+		assert.Equal(t, s.Latest(), 2)
+
+		applied, err := migrate(ctx, db, migrations[:1])
+		assert.NilError(t, err)
+		assert.DeepEqual(t, applied, migrations[:1])
+
+		applied, err = migrate(ctx, db, migrations)
+		assert.NilError(t, err)
+		assert.DeepEqual(t, applied, migrations[1:])
+
+		s, err = getStatus(ctx, db, migrations)
+		assert.NilError(t, err)
+		assert.Assert(t, !s.Empty && !s.Legacy)
+		assert.Equal(t, s.Version, 2)
+		assert.Equal(t, len(s.Applied), 2)
+		assert.Equal(t, len(s.Pending), 0)
+
+		applied, err = migrate(ctx, db, migrations)
+		assert.NilError(t, err)
+		assert.Equal(t, len(applied), 0)
+	})
+
+	t.Run("Failed migration is rolled back", func(t *testing.T) {
+		t.Parallel()
+
+		db := openDB(t)
+		_, err := migrate(ctx, db, []Migration{
+			migrations[0],
+			{Version: 2, Name: "broken", SQL: "CREATE TABLE tags (id INTEGER PRIMARY KEY); SELECT * FROM missing;"},
+		})
+		assert.ErrorContains(t, err, "apply migration 2 (broken)")
+
+		s, err := getStatus(ctx, db, migrations)
+		assert.NilError(t, err)
+		assert.Equal(t, s.Version, 1)
+		exists, err := tableExists(ctx, db, "tags")
+		assert.NilError(t, err)
+		assert.Assert(t, !exists)
+	})
+
+	t.Run("Legacy database", func(t *testing.T) {
+		t.Parallel()
+
+		db := openDB(t)
+		_, err := db.ExecContext(ctx, `CREATE TABLE aips (
+			id INTEGER PRIMARY KEY,
+			uuid TEXT NOT NULL UNIQUE CHECK (LENGTH(uuid) == 36),
+			status TEXT NOT NULL DEFAULT 'new',
+			found BOOLEAN NOT NULL DEFAULT FALSE,
+			fixity_run BOOLEAN NOT NULL DEFAULT FALSE,
+			moved BOOLEAN NOT NULL DEFAULT FALSE,
+			cleaned BOOLEAN NOT NULL DEFAULT FALSE,
+			replicated BOOLEAN NOT NULL DEFAULT FALSE,
+			re_indexed BOOLEAN NOT NULL DEFAULT FALSE,
+			current_location TEXT DEFAULT '',
+			"size" UNSIGNED BIG INT,
+			location_uuid TEXT
+		);
+		INSERT INTO aips (uuid) VALUES ('2faa61dc-ed33-49f4-8b36-954f203bab4a');`)
+		assert.NilError(t, err)
+
+		s, err := GetStatus(ctx, db)
+		assert.NilError(t, err)
+		assert.Assert(t, s.Legacy)
+
+		_, err = Migrate(ctx, db)
+		assert.NilError(t, err)
+
+		var priority, tags int
+		row := db.QueryRowContext(ctx, "SELECT priority, LENGTH(tags) FROM aips")
+		assert.NilError(t, row.Scan(&priority, &tags))
+		exists, err := tableExists(ctx, db, "batches")
+		assert.NilError(t, err)
+		assert.Assert(t, exists)
+
+		s, err = GetStatus(ctx, db)
+		assert.NilError(t, err)
+		assert.Assert(t, !s.Legacy)
+		assert.Equal(t, len(s.Pending), 0)
+	})
+}
+
+func TestBackup(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	db := openDB(t)
+	_, err := Migrate(ctx, db)
+	assert.NilError(t, err)
+
+	path := filepath.Join(t.TempDir(), "migrate.db.bak")
+	assert.NilError(t, Backup(ctx, db, path))
+
+	backup, err := bob.Open("sqlite", path)
+	assert.NilError(t, err)
+	defer backup.Close() //nolint:errcheck
+	s, err := GetStatus(ctx, backup)
+	assert.NilError(t, err)
+	assert.Equal(t, len(s.Pending), 0)
+
+	// An existing backup is never overwritten.
+	assert.ErrorContains(t, Backup(ctx, db, path), "back up database")
+}
+
+func TestEventOutcome(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	all, err := All()
+	assert.NilError(t, err)
+
+	db := openDB(t)
+	_, err = migrate(ctx, db, all[:3])
+	assert.NilError(t, err)
+	_, err = db.ExecContext(ctx, `
+		INSERT INTO aips (id, uuid, status) VALUES
+			(1, '2faa61dc-ed33-49f4-8b36-954f203bab4a', 'moved'),
+			(2, '6e1076b3-e79c-49c8-bbf5-850963596b3c', 'failed');
+		INSERT INTO events (aip_id, action, time_started, time_ended) VALUES
+			(1, 'move', '', '2025-01-01 12:00:00.123456789 +0000 UTC m=+0.012345678'),
+			(2, 'move', '', '2025-01-01 05:00:00 -0700 MST');`)
+	assert.NilError(t, err)
+
+	_, err = migrate(ctx, db, all)
+	assert.NilError(t, err)
+
+	rows, err := db.QueryContext(ctx, "SELECT success, ended_at FROM events ORDER BY aip_id")
+	assert.NilError(t, err)
+	defer rows.Close() //nolint:errcheck
+
+	type outcome struct {
+		Success bool
+		EndedAt int64
+	}
+	var got []outcome
+	for rows.Next() {
+		var o outcome
+		assert.NilError(t, rows.Scan(&o.Success, &o.EndedAt))
+		got = append(got, o)
+	}
+	assert.NilError(t, rows.Err())
+
+	// Both ended at 2025-01-01 12:00:00 UTC.
+	assert.DeepEqual(t, got, []outcome{{true, 1735732800}, {false, 1735732800}})
+}
@@ -14,6 +14,7 @@
 // that migrate uses, including:
 //   - GET /api/v2/file/{uuid}/ - retrieve package details
 //   - POST /api/v2/file/{uuid}/move/ - initiate a package move
+//   - POST /api/v2/file/{uuid}/delete_aip/ - request a package deletion
 //   - GET /api/v2/location/{uuid}/ - retrieve location details
 //   - POST /_internal/replicate - create package replicas
 //
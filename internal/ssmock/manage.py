@@ -18,6 +18,9 @@ def main() -> int:
     replicate.add_argument("--aip-store-location", required=True)
     replicate.add_argument("--replicator-location", required=True)
 
+    reindex = subparsers.add_parser("rebuild_aip_index_from_storage_service")
+    reindex.add_argument("--uuid", required=True)
+
     args = parser.parse_args()
 
     base_url = os.environ.get("SSMOCK_URL")
@@ -27,6 +30,9 @@ def main() -> int:
     if base_url.endswith("/"):
         base_url = base_url[:-1]
 
+    if args.command == "rebuild_aip_index_from_storage_service":
+        return rebuild_aip_index(base_url, args.uuid)
+
     payload = json.dumps(
         {
             "aip_uuid": args.aip_uuid,
@@ -56,6 +62,10 @@ def main() -> int:
     status = data.get("status")
     if status == "success":
         print(f"INFO: Starting replication for {args.aip_uuid}")
+        print(
+            f"INFO: Replica {data.get('replica_uuid')} created in location "
+            f"{args.replicator_location}"
+        )
         print(
             "New replicas created for 1 of 1 AIPs in location "
             f"{args.replicator_location}"
@@ -79,5 +89,22 @@ def main() -> int:
     return 1
 
 
+def rebuild_aip_index(base_url: str, aip_uuid: str) -> int:
+    # Like the dashboard command, fail when the AIP cannot be indexed.
+    try:
+        with urllib.request.urlopen(f"{base_url}/api/v2/file/{aip_uuid}/"):
+            pass
+    except urllib.error.HTTPError as exc:
+        print(f"CommandError: {exc.reason}", file=sys.stderr)
+        return 1
+    except Exception as exc:  # pylint: disable=broad-except
+        print(f"CommandError: {exc}", file=sys.stderr)
+        return 1
+
+    print(f"Rebuilding AIP index for {aip_uuid}")
+    print("Indexing complete. Indexed 1 AIPs.")
+    return 0
+
+
 if __name__ == "__main__":
     sys.exit(main())
@@ -10,6 +10,7 @@ import (
 	"net"
 	"net/http"
 	"slices"
+	"strconv"
 	"strings"
 	"sync"
 	"time"
@@ -241,13 +242,26 @@ func (s *Server) handleFile(w http.ResponseWriter, r *http.Request) {
 		s.handleMove(w, r, id)
 		return
 	}
+	if strings.HasSuffix(remainder, "/delete_aip/") {
+		if r.Method != http.MethodPost {
+			methodNotAllowed(w, http.MethodPost)
+			return
+		}
+		id := strings.TrimSuffix(remainder, "/delete_aip/")
+		if id == "" {
+			http.NotFound(w, r)
+			return
+		}
+		s.handleDeleteAIP(w, r, id)
+		return
+	}
 	if r.Method != http.MethodGet {
 		methodNotAllowed(w, http.MethodGet)
 		return
 	}
 	id := strings.TrimSuffix(remainder, "/")
 	if id == "" {
-		http.NotFound(w, r)
+		s.handleListFiles(w, r)
 		return
 	}
 
@@ -261,6 +275,90 @@ func (s *Server) handleFile(w http.ResponseWriter, r *http.Request) {
 	writeJSON(w, pkg)
 }
 
+// defaultListLimit is the page size of the Storage Service API.
+const defaultListLimit = 20
+
+type listMeta struct {
+	Limit      int     `json:"limit"`
+	Offset     int     `json:"offset"`
+	TotalCount int     `json:"total_count"`
+	Next       *string `json:"next"`
+	Previous   *string `json:"previous"`
+}
+
+type fileList struct {
+	Meta    listMeta                  `json:"meta"`
+	Objects []storage_service.Package `json:"objects"`
+}
+
+// handleListFiles lists the packages in the order they were added, filtered
+// by the current_location and package_type parameters and paginated with
+// limit and offset like the Storage Service.
+func (s *Server) handleListFiles(w http.ResponseWriter, r *http.Request) {
+	q := r.URL.Query()
+	limit, offset := defaultListLimit, 0
+	if v := q.Get("limit"); v != "" {
+		n, err := strconv.Atoi(v)
+		if err != nil || n < 0 {
+			http.Error(w, "invalid limit", http.StatusBadRequest)
+			return
+		}
+		limit = n
+	}
+	if v := q.Get("offset"); v != "" {
+		n, err := strconv.Atoi(v)
+		if err != nil || n < 0 {
+			http.Error(w, "invalid offset", http.StatusBadRequest)
+			return
+		}
+		offset = n
+	}
+	var location string
+	if v := q.Get("current_location"); v != "" {
+		location = storage_service.ResourceUUID(v)
+	}
+	packageType := q.Get("package_type")
+
+	var matches []storage_service.Package
+	s.mu.RLock()
+	for _, id := range s.state.packageOrder {
+		pkgState := s.state.packages[id]
+		if location != "" && pkgState.locationID != location {
+			continue
+		}
+		if packageType != "" && !strings.EqualFold(pkgState.pkg.PackageType, packageType) {
+			continue
+		}
+		pkg, _ := s.state.clonePackage(id)
+		matches = append(matches, *pkg)
+	}
+	s.mu.RUnlock()
+
+	res := fileList{
+		Meta:    listMeta{Limit: limit, Offset: offset, TotalCount: len(matches)},
+		Objects: []storage_service.Package{},
+	}
+	page := func(offset int) *string {
+		q.Set("offset", strconv.Itoa(offset))
+		uri := "/api/v2/file/?" + q.Encode()
+		return &uri
+	}
+	if offset < len(matches) {
+		end := len(matches)
+		if limit > 0 {
+			end = min(offset+limit, len(matches))
+		}
+		res.Objects = matches[offset:end]
+		if end < len(matches) {
+			res.Meta.Next = page(end)
+		}
+	}
+	if offset > 0 {
+		res.Meta.Previous = page(max(offset-limit, 0))
+	}
+	writeJSON(w, &res)
+}
+
 func (s *Server) handleCheckFixity(w http.ResponseWriter, r *http.Request, id string) {
 	s.mu.RLock()
 	pkg, ok := s.state.clonePackage(id)
@@ -278,6 +376,39 @@ func (s *Server) handleCheckFixity(w http.ResponseWriter, r *http.Request, id st
 	writeJSON(w, &resp)
 }
 
+// handleDeleteAIP records a deletion request. Like the Storage Service, the
+// package is only flagged with the DEL_REQ status until the request is
+// approved, which the simulator never does.
+func (s *Server) handleDeleteAIP(w http.ResponseWriter, r *http.Request, id string) {
+	var req storage_service.DeletionRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		http.Error(w, fmt.Sprintf("invalid request: %v", err), http.StatusBadRequest)
+		return
+	}
+	if req.EventReason == "" || req.Pipeline == "" || req.UserID == 0 || req.UserEmail == "" {
+		http.Error(w, "event_reason, pipeline, user_id and user_email are required", http.StatusBadRequest)
+		return
+	}
+
+	s.mu.Lock()
+	pkgState, ok := s.state.packages[id]
+	if !ok {
+		s.mu.Unlock()
+		http.NotFound(w, r)
+		return
+	}
+	message := "A deletion request already exists for this AIP."
+	if pkgState.pkg.Status != "DEL_REQ" {
+		pkgState.pkg.Status = "DEL_REQ"
+		message = "Delete request created successfully."
+	}
+	s.mu.Unlock()
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusAccepted)
+	_ = json.NewEncoder(w).Encode(map[string]string{"message": message})
+}
+
 func (s *Server) handleMove(w http.ResponseWriter, r *http.Request, id string) {
 	if err := r.ParseForm(); err != nil {
 		http.Error(w, fmt.Sprintf("invalid form: %v", err), http.StatusBadRequest)
@@ -476,6 +607,7 @@ func (s *Server) handleReplicate(w http.ResponseWriter, r *http.Request) {
 		locationID: req.ReplicaLocationUUID,
 		previousID: req.ReplicaLocationUUID,
 	}
+	s.state.packageOrder = append(s.state.packageOrder, replicaID)
 
 	if !slices.Contains(pkgState.pkg.Replicas, replicaURI) {
 		pkgState.pkg.Replicas = append(append([]string(nil), pkgState.pkg.Replicas...), replicaURI)
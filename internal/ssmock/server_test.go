@@ -57,6 +57,53 @@ func TestGetPackage(t *testing.T) {
 	}
 }
 
+func TestListPackages(t *testing.T) {
+	t.Parallel()
+
+	cfg := testConfig()
+	cfg.Locations[0].Packages = append(cfg.Locations[0].Packages,
+		PackageConfig{ID: "pkg-2"},
+		PackageConfig{ID: "pkg-3", PackageType: "DIP"},
+		PackageConfig{ID: "pkg-4"},
+		PackageConfig{ID: "pkg-5"},
+	)
+	cfg.Locations[1].Packages = []PackageConfig{{ID: "pkg-6"}}
+	srv := StartTestServer(t, cfg)
+	client := storage_service.NewAPI(http.DefaultClient, srv.Addr(), "", "")
+
+	pkgs, err := client.Packages.List(t.Context(), storage_service.PackageListOptions{
+		LocationID:  "loc-1",
+		PackageType: "AIP",
+		PageSize:    3,
+	})
+	if err != nil {
+		t.Fatalf("list packages: %v", err)
+	}
+	var ids []string
+	for _, pkg := range pkgs {
+		ids = append(ids, pkg.UUID)
+	}
+	if got, want := strings.Join(ids, ","), "pkg-1,pkg-2,pkg-4,pkg-5"; got != want {
+		t.Fatalf("unexpected packages: got %s, want %s", got, want)
+	}
+
+	resp, err := http.Get(fmt.Sprintf("http://%s/api/v2/file/?limit=2&offset=1", srv.Addr())) //nolint:noctx
+	if err != nil {
+		t.Fatalf("list packages: %v", err)
+	}
+	defer resp.Body.Close() //nolint:errcheck
+	var page fileList
+	if err := json.NewDecoder(resp.Body).Decode(&page); err != nil {
+		t.Fatalf("decode list: %v", err)
+	}
+	if page.Meta.TotalCount != 6 || len(page.Objects) != 2 || page.Objects[0].UUID != "pkg-2" {
+		t.Fatalf("unexpected page: %+v", page.Meta)
+	}
+	if page.Meta.Next == nil || *page.Meta.Next != "/api/v2/file/?limit=2&offset=3" {
+		t.Fatalf("unexpected next page: %v", page.Meta.Next)
+	}
+}
+
 func TestMovePackage(t *testing.T) {
 	t.Parallel()
 
@@ -85,6 +132,37 @@ func TestMovePackage(t *testing.T) {
 	t.Fatalf("package did not move before timeout")
 }
 
+func TestDeletePackage(t *testing.T) {
+	t.Parallel()
+
+	srv := StartTestServer(t, testConfig(), WithMoveDelay(5*time.Millisecond))
+	baseURL := fmt.Sprintf("http://%s", srv.Addr())
+
+	body := `{"event_reason":"Migrated","pipeline":"pipeline-1","user_id":1,"user_email":"admin@example.com"}`
+	resp, err := http.Post(fmt.Sprintf("%s/api/v2/file/%s/delete_aip/", baseURL, "pkg-1"), "application/json", strings.NewReader(body)) //nolint:noctx
+	if err != nil {
+		t.Fatalf("delete package: %v", err)
+	}
+	resp.Body.Close() //nolint:errcheck
+	if resp.StatusCode != http.StatusAccepted {
+		t.Fatalf("expected 202, got %d", resp.StatusCode)
+	}
+
+	pkg := fetchPackage(t, baseURL, "pkg-1")
+	if pkg.Status != "DEL_REQ" {
+		t.Fatalf("unexpected status: %s", pkg.Status)
+	}
+
+	resp, err = http.Post(fmt.Sprintf("%s/api/v2/file/%s/delete_aip/", baseURL, "pkg-1"), "application/json", strings.NewReader(`{}`)) //nolint:noctx
+	if err != nil {
+		t.Fatalf("delete package: %v", err)
+	}
+	resp.Body.Close() //nolint:errcheck
+	if resp.StatusCode != http.StatusBadRequest {
+		t.Fatalf("expected 400 for an incomplete request, got %d", resp.StatusCode)
+	}
+}
+
 func TestReplicatePackage(t *testing.T) {
 	t.Parallel()
 
@@ -11,6 +11,7 @@ type serverState struct {
 	locations     map[string]*locationState
 	locationOrder []string
 	packages      map[string]*packageState
+	packageOrder  []string
 }
 
 type locationState struct {
@@ -89,6 +90,11 @@ func newStateFromConfig(cfg *Config) (*serverState, error) {
 				replicas[i] = packageResource(replica)
 			}
 
+			packageType := pkg.PackageType
+			if packageType == "" {
+				packageType = "AIP"
+			}
+
 			replicatedPackage := pkg.ReplicatedPackage
 			if replicatedPackage != "" {
 				replicatedPackage = packageResource(replicatedPackage)
@@ -104,7 +110,7 @@ func newStateFromConfig(cfg *Config) (*serverState, error) {
 					CurrentPath:       currentPath,
 					Encrypted:         pkg.Encrypted,
 					OriginPipeline:    pkg.OriginPipeline,
-					PackageType:       pkg.PackageType,
+					PackageType:       packageType,
 					RelatedPackages:   nil,
 					Replicas:          replicas,
 					ReplicatedPackage: replicatedPackage,
@@ -115,6 +121,7 @@ func newStateFromConfig(cfg *Config) (*serverState, error) {
 				},
 			}
 
+			st.packageOrder = append(st.packageOrder, pkg.ID)
 			st.locations[loc.ID].location.Used += int(pkg.Size)
 		}
 	}
@@ -2,9 +2,11 @@ package storage_service
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
 	"net/http"
 	"net/url"
+	"strconv"
 )
 
 type PackageService struct {
@@ -35,6 +37,59 @@ func (s *PackageService) GetByID(ctx context.Context, id string) (*Package, erro
 	return pkg, err
 }
 
+// DefaultPageSize is the number of packages List requests at a time unless
+// told otherwise.
+const DefaultPageSize = 100
+
+// PackageListOptions filters the packages returned by List.
+type PackageListOptions struct {
+	// LocationID only lists the packages stored in the location.
+	LocationID string
+	// PackageType only lists the packages of the type, e.g. "AIP".
+	PackageType string
+	// PageSize is the number of packages requested at a time.
+	PageSize int
+}
+
+type packageList struct {
+	Meta struct {
+		Limit      int `json:"limit"`
+		Offset     int `json:"offset"`
+		TotalCount int `json:"total_count"`
+	} `json:"meta"`
+	Objects []Package `json:"objects"`
+}
+
+// List returns the packages matching opts, requesting them from the Storage
+// Service a page at a time.
+func (s *PackageService) List(ctx context.Context, opts PackageListOptions) ([]Package, error) {
+	limit := opts.PageSize
+	if limit <= 0 {
+		limit = DefaultPageSize
+	}
+	p := url.Values{}
+	if opts.LocationID != "" {
+		p.Set("current_location", opts.LocationID)
+	}
+	if opts.PackageType != "" {
+		p.Set("package_type", opts.PackageType)
+	}
+	p.Set("limit", strconv.Itoa(limit))
+
+	var pkgs []Package
+	for {
+		p.Set("offset", strconv.Itoa(len(pkgs)))
+		var page packageList
+		if err := s.client.Call(ctx, http.MethodGet, "/api/v2/file/?"+p.Encode(), nil, &page); err != nil {
+			return nil, err
+		}
+		pkgs = append(pkgs, page.Objects...)
+		if len(page.Objects) == 0 || len(pkgs) >= page.Meta.TotalCount {
+			return pkgs, nil
+		}
+	}
+}
+
 func (s *PackageService) Move(ctx context.Context, packageID, locationID string) error {
 	path := fmt.Sprintf("/api/v2/file/%s/move/", packageID)
 	p := url.Values{}
@@ -63,3 +118,21 @@ func (s *PackageService) CheckFixity(ctx context.Context, id string) (*FixityRes
 	err := s.client.Call(ctx, http.MethodGet, path, nil, res)
 	return res, err
 }
+
+type DeletionRequest struct {
+	EventReason string `json:"event_reason"`
+	Pipeline    string `json:"pipeline"`
+	UserID      int    `json:"user_id"`
+	UserEmail   string `json:"user_email"`
+}
+
+// RequestDeletion files a request to delete the package, which an
+// administrator approves or rejects in the Storage Service.
+func (s *PackageService) RequestDeletion(ctx context.Context, id string, req DeletionRequest) error {
+	body, err := json.Marshal(req)
+	if err != nil {
+		return err
+	}
+	path := fmt.Sprintf("/api/v2/file/%s/delete_aip/", id)
+	return s.client.Call(ctx, http.MethodPost, path, string(body), nil)
+}
@@ -8,6 +8,7 @@ import (
 	"fmt"
 	"io"
 	"net/http"
+	"path"
 	"strings"
 )
 
@@ -59,6 +60,20 @@ func (e SSError) Error() string {
 	return fmt.Sprintf("%s: (%d): url: %s method: %s", e.Message, e.StatusCode, e.URL, e.Method)
 }
 
+// Retryable reports whether the request may succeed if it is sent again, i.e.
+// the Storage Service failed with a server error, timed out or is rate limiting
+// requests.
+func (e SSError) Retryable() bool {
+	switch {
+	case e.StatusCode >= 500:
+		return true
+	case e.StatusCode == http.StatusRequestTimeout, e.StatusCode == http.StatusTooManyRequests:
+		return true
+	default:
+		return false
+	}
+}
+
 func NewSSError(res *http.Response, body []byte, reqURL string) error {
 	SSErr := SSError{
 		URL:        reqURL,
@@ -116,3 +131,9 @@ func (c *Client) Call(ctx context.Context, method, path string, reqBody, resPayl
 	}
 	return nil
 }
+
+// ResourceUUID returns the UUID at the end of a resource URI such as
+// "/api/v2/file/UUID/" or "/api/v2/location/UUID/".
+func ResourceUUID(uri string) string {
+	return path.Base(strings.TrimSuffix(uri, "/"))
+}
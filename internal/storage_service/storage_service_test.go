@@ -29,3 +29,30 @@ func TestAPI(t *testing.T) {
 	assert.NilError(t, err)
 	assert.Equal(t, pkg.UUID, "9607cd13-99cd-46c9-82e6-4d7ef86ccaf7")
 }
+
+func TestSSErrorRetryable(t *testing.T) {
+	t.Parallel()
+
+	for _, tc := range []struct {
+		code      int
+		retryable bool
+	}{
+		{code: http.StatusBadRequest, retryable: false},
+		{code: http.StatusUnauthorized, retryable: false},
+		{code: http.StatusRequestTimeout, retryable: true},
+		{code: http.StatusTooManyRequests, retryable: true},
+		{code: http.StatusInternalServerError, retryable: true},
+		{code: http.StatusBadGateway, retryable: true},
+		{code: http.StatusServiceUnavailable, retryable: true},
+	} {
+		err := storage_service.SSError{StatusCode: tc.code}
+		assert.Equal(t, err.Retryable(), tc.retryable, "status code %d", tc.code)
+	}
+}
+
+func TestResourceUUID(t *testing.T) {
+	t.Parallel()
+
+	assert.Equal(t, storage_service.ResourceUUID("/api/v2/file/9607cd13-99cd-46c9-82e6-4d7ef86ccaf7/"), "9607cd13-99cd-46c9-82e6-4d7ef86ccaf7")
+	assert.Equal(t, storage_service.ResourceUUID("/api/v2/location/0a8bfbd8-2c0f-4b5c-a1e6-7d6f8b1e8c35"), "0a8bfbd8-2c0f-4b5c-a1e6-7d6f8b1e8c35")
+}
@@ -10,12 +10,16 @@ import (
 	"github.com/peterbourgon/ff/v4"
 	"github.com/peterbourgon/ff/v4/ffhelp"
 
+	"github.com/artefactual-labs/migrate/internal/cmd/batchcmd"
+	"github.com/artefactual-labs/migrate/internal/cmd/dbcmd"
 	"github.com/artefactual-labs/migrate/internal/cmd/exportcmd"
 	"github.com/artefactual-labs/migrate/internal/cmd/listfiltercmd"
 	"github.com/artefactual-labs/migrate/internal/cmd/loadinputcmd"
 	"github.com/artefactual-labs/migrate/internal/cmd/movecmd"
+	"github.com/artefactual-labs/migrate/internal/cmd/pipelinecmd"
 	"github.com/artefactual-labs/migrate/internal/cmd/replicatecmd"
 	"github.com/artefactual-labs/migrate/internal/cmd/rootcmd"
+	"github.com/artefactual-labs/migrate/internal/cmd/statuscmd"
 	"github.com/artefactual-labs/migrate/internal/cmd/versioncmd"
 	"github.com/artefactual-labs/migrate/internal/cmd/workercmd"
 )
@@ -38,11 +42,15 @@ func main() {
 
 func exec(ctx context.Context, args []string, stdin io.Reader, stdout, stderr io.Writer) error {
 	root := rootcmd.New(stdin, stdout, stderr)
+	_ = batchcmd.New(root)
+	_ = dbcmd.New(root)
 	_ = exportcmd.New(root)
 	_ = listfiltercmd.New(root)
 	_ = loadinputcmd.New(root)
 	_ = movecmd.New(root)
+	_ = pipelinecmd.New(root)
 	_ = replicatecmd.New(root)
+	_ = statuscmd.New(root)
 	_ = versioncmd.New(root)
 	_ = workercmd.New(root)
 